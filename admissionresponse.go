@@ -22,3 +22,11 @@ func admissionResponse(admissionCode int32, admissionPermissions bool, admission
 		},
 	}
 }
+
+func admissionPatchResponse(admissionCode int32, admissionMessage string, patch []byte, ar *v1.AdmissionReview) v1.AdmissionReview {
+	review := admissionResponse(admissionCode, true, "Success", admissionMessage, ar)
+	patchType := v1.PatchTypeJSONPatch
+	review.Response.Patch = patch
+	review.Response.PatchType = &patchType
+	return review
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	v1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes/scheme"
+	rest "k8s.io/client-go/rest"
+)
+
+// AlertmanagersGetter has a method to return a AlertmanagerInterface.
+// A group's client should implement this interface.
+type AlertmanagersGetter interface {
+	Alertmanagers(namespace string) AlertmanagerInterface
+}
+
+// AlertmanagerInterface has methods to work with Alertmanager resources.
+type AlertmanagerInterface interface {
+	Update(ctx context.Context, alertmanager *v1.Alertmanager, opts metav1.UpdateOptions) (*v1.Alertmanager, error)
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (*v1.Alertmanager, error)
+	List(ctx context.Context, opts metav1.ListOptions) (*v1.AlertmanagerList, error)
+	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *v1.Alertmanager, err error)
+	AlertmanagerExpansion
+}
+
+// alertmanagers implements AlertmanagerInterface
+type alertmanagers struct {
+	client rest.Interface
+	ns     string
+}
+
+// newAlertmanagers returns a Alertmanagers
+func newAlertmanagers(c *MonitoringV1Client, namespace string) *alertmanagers {
+	return &alertmanagers{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the alertmanager, and returns the corresponding alertmanager object, and an error if there is any.
+func (c *alertmanagers) Get(ctx context.Context, name string, options metav1.GetOptions) (result *v1.Alertmanager, err error) {
+	result = &v1.Alertmanager{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("alertmanagers").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of Alertmanagers that match those selectors.
+func (c *alertmanagers) List(ctx context.Context, opts metav1.ListOptions) (result *v1.AlertmanagerList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1.AlertmanagerList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("alertmanagers").
+		VersionedParams(&opts, ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested alertmanagers.
+func (c *alertmanagers) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("alertmanagers").
+		VersionedParams(&opts, ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Update takes the representation of an alertmanager and updates it. Returns the server's representation of the alertmanager, and an error, if there is any.
+func (c *alertmanagers) Update(ctx context.Context, alertmanager *v1.Alertmanager, opts metav1.UpdateOptions) (result *v1.Alertmanager, err error) {
+	result = &v1.Alertmanager{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("alertmanagers").
+		Name(alertmanager.Name).
+		VersionedParams(&opts, ParameterCodec).
+		Body(alertmanager).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Patch applies the patch and returns the patched alertmanager.
+func (c *alertmanagers) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *v1.Alertmanager, err error) {
+	result = &v1.Alertmanager{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("alertmanagers").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
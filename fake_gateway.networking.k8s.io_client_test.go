@@ -0,0 +1,35 @@
+package main
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	rest "k8s.io/client-go/rest"
+	"k8s.io/client-go/testing"
+)
+
+// FakeGatewayV1 implements GatewayV1Interface with an in-memory object tracker
+type FakeGatewayV1 struct {
+	*testing.Fake
+}
+
+var _ GatewayV1Interface = &FakeGatewayV1{}
+
+func (c *FakeGatewayV1) HTTPRoutes(namespace string) HTTPRouteInterface {
+	return &FakeHTTPRoutes{c, namespace}
+}
+
+func (c *FakeGatewayV1) Gateways(namespace string) GatewayInterface {
+	return &FakeGateways{c, namespace}
+}
+
+// RESTClient returns a RESTClient that is used to communicate
+// with API server by this client implementation.
+func (c *FakeGatewayV1) RESTClient() rest.Interface {
+	var ret *rest.RESTClient
+	return ret
+}
+
+// NewSimpleFakeGatewayV1 returns a FakeGatewayV1 whose tracker is seeded with the given objects,
+// errors are injected with PrependReactor.
+func NewSimpleFakeGatewayV1(objects ...runtime.Object) *FakeGatewayV1 {
+	return &FakeGatewayV1{newFakeTracker(objects...)}
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeGateways implements GatewayInterface
+type FakeGateways struct {
+	Fake *FakeGatewayV1
+	ns   string
+}
+
+var gatewaysResource = GatewaySchemeGroupVersion.WithResource("gateways")
+
+var gatewaysKind = GatewaySchemeGroupVersion.WithKind("Gateway")
+
+// Get takes name of the gateway, and returns the corresponding gateway object, and an error if there is any.
+func (c *FakeGateways) Get(ctx context.Context, name string, options metav1.GetOptions) (result *Gateway, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(gatewaysResource, c.ns, name), &Gateway{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*Gateway), err
+}
+
+// List takes label and field selectors, and returns the list of Gateways that match those selectors.
+func (c *FakeGateways) List(ctx context.Context, opts metav1.ListOptions) (result *GatewayList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(gatewaysResource, gatewaysKind, c.ns, opts), &GatewayList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &GatewayList{ListMeta: obj.(*GatewayList).ListMeta}
+	for _, item := range obj.(*GatewayList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested gateways.
+func (c *FakeGateways) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(gatewaysResource, c.ns, opts))
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	rest "k8s.io/client-go/rest"
+	"k8s.io/client-go/testing"
+)
+
+// FakeGrafanaV1beta1 implements GrafanaV1beta1Interface with an in-memory object tracker
+type FakeGrafanaV1beta1 struct {
+	*testing.Fake
+}
+
+var _ GrafanaV1beta1Interface = &FakeGrafanaV1beta1{}
+
+func (c *FakeGrafanaV1beta1) Grafanas(namespace string) GrafanaInterface {
+	return &FakeGrafanas{c, namespace}
+}
+
+// RESTClient returns a RESTClient that is used to communicate
+// with API server by this client implementation.
+func (c *FakeGrafanaV1beta1) RESTClient() rest.Interface {
+	var ret *rest.RESTClient
+	return ret
+}
+
+// NewSimpleFakeGrafanaV1beta1 returns a FakeGrafanaV1beta1 whose tracker is seeded with the given objects,
+// errors are injected with PrependReactor.
+func NewSimpleFakeGrafanaV1beta1(objects ...runtime.Object) *FakeGrafanaV1beta1 {
+	return &FakeGrafanaV1beta1{newFakeTracker(objects...)}
+}
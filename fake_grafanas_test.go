@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeGrafanas implements GrafanaInterface
+type FakeGrafanas struct {
+	Fake *FakeGrafanaV1beta1
+	ns   string
+}
+
+var grafanasResource = GrafanaSchemeGroupVersion.WithResource("grafanas")
+
+var grafanasKind = GrafanaSchemeGroupVersion.WithKind("Grafana")
+
+// Get takes name of the grafana, and returns the corresponding grafana object, and an error if there is any.
+func (c *FakeGrafanas) Get(ctx context.Context, name string, options metav1.GetOptions) (result *Grafana, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(grafanasResource, c.ns, name), &Grafana{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*Grafana), err
+}
+
+// List takes label and field selectors, and returns the list of Grafanas that match those selectors.
+func (c *FakeGrafanas) List(ctx context.Context, opts metav1.ListOptions) (result *GrafanaList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(grafanasResource, grafanasKind, c.ns, opts), &GrafanaList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &GrafanaList{ListMeta: obj.(*GrafanaList).ListMeta}
+	for _, item := range obj.(*GrafanaList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested grafanas.
+func (c *FakeGrafanas) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(grafanasResource, c.ns, opts))
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeHTTPRoutes implements HTTPRouteInterface
+type FakeHTTPRoutes struct {
+	Fake *FakeGatewayV1
+	ns   string
+}
+
+var httproutesResource = GatewaySchemeGroupVersion.WithResource("httproutes")
+
+var httproutesKind = GatewaySchemeGroupVersion.WithKind("HTTPRoute")
+
+// Get takes name of the httpRoute, and returns the corresponding httpRoute object, and an error if there is any.
+func (c *FakeHTTPRoutes) Get(ctx context.Context, name string, options metav1.GetOptions) (result *HTTPRoute, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(httproutesResource, c.ns, name), &HTTPRoute{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*HTTPRoute), err
+}
+
+// List takes label and field selectors, and returns the list of HTTPRoutes that match those selectors.
+func (c *FakeHTTPRoutes) List(ctx context.Context, opts metav1.ListOptions) (result *HTTPRouteList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(httproutesResource, httproutesKind, c.ns, opts), &HTTPRouteList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &HTTPRouteList{ListMeta: obj.(*HTTPRouteList).ListMeta}
+	for _, item := range obj.(*HTTPRouteList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested httproutes.
+func (c *FakeHTTPRoutes) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(httproutesResource, c.ns, opts))
+}
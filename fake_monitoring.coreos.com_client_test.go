@@ -0,0 +1,60 @@
+package main
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	rest "k8s.io/client-go/rest"
+	"k8s.io/client-go/testing"
+)
+
+// FakeMonitoringV1 implements MonitoringV1Interface with an in-memory object tracker
+type FakeMonitoringV1 struct {
+	*testing.Fake
+}
+
+var _ MonitoringV1Interface = &FakeMonitoringV1{}
+
+func (c *FakeMonitoringV1) Prometheuses(namespace string) PrometheusInterface {
+	return &FakePrometheuses{c, namespace}
+}
+
+func (c *FakeMonitoringV1) Alertmanagers(namespace string) AlertmanagerInterface {
+	return &FakeAlertmanagers{c, namespace}
+}
+
+func (c *FakeMonitoringV1) ThanosRulers(namespace string) ThanosRulerInterface {
+	return &FakeThanosRulers{c, namespace}
+}
+
+// RESTClient returns a RESTClient that is used to communicate
+// with API server by this client implementation.
+func (c *FakeMonitoringV1) RESTClient() rest.Interface {
+	var ret *rest.RESTClient
+	return ret
+}
+
+// NewSimpleFakeMonitoringV1 returns a FakeMonitoringV1 whose tracker is seeded with the given objects,
+// errors are injected with PrependReactor.
+func NewSimpleFakeMonitoringV1(objects ...runtime.Object) *FakeMonitoringV1 {
+	return &FakeMonitoringV1{newFakeTracker(objects...)}
+}
+
+// newFakeSources returns source clients seeded with the given objects, the custom resources
+// share one tracker and the Services are in a fake clientset
+func newFakeSources(objects ...runtime.Object) *sourceClients {
+	var custom, core []runtime.Object
+	for _, obj := range objects {
+		if _, ok := obj.(*corev1.Service); ok {
+			core = append(core, obj)
+		} else {
+			custom = append(custom, obj)
+		}
+	}
+	fake := newFakeTracker(custom...)
+	return &sourceClients{
+		monitoring: &FakeMonitoringV1{fake},
+		grafana:    &FakeGrafanaV1beta1{fake},
+		services:   kubefake.NewSimpleClientset(core...).CoreV1(),
+	}
+}
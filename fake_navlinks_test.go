@@ -0,0 +1,114 @@
+package main
+
+import (
+	"context"
+
+	v1 "github.com/rancher/rancher/pkg/apis/ui.cattle.io/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeNavLinks implements NavLinkInterface
+type FakeNavLinks struct {
+	Fake *FakeUiV1
+}
+
+var navlinksResource = v1.SchemeGroupVersion.WithResource("navlinks")
+
+var navlinksKind = v1.SchemeGroupVersion.WithKind("NavLink")
+
+// Get takes name of the navlink, and returns the corresponding navlink object, and an error if there is any.
+func (c *FakeNavLinks) Get(ctx context.Context, name string, options metav1.GetOptions) (result *v1.NavLink, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(navlinksResource, name), &v1.NavLink{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.NavLink), err
+}
+
+// List takes label and field selectors, and returns the list of NavLinks that match those selectors.
+func (c *FakeNavLinks) List(ctx context.Context, opts metav1.ListOptions) (result *v1.NavLinkList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(navlinksResource, navlinksKind, opts), &v1.NavLinkList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1.NavLinkList{ListMeta: obj.(*v1.NavLinkList).ListMeta}
+	for _, item := range obj.(*v1.NavLinkList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested navlinks.
+func (c *FakeNavLinks) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(navlinksResource, opts))
+}
+
+// Create takes the representation of a navlink and creates it.  Returns the server's representation of the navlink, and an error, if there is any.
+func (c *FakeNavLinks) Create(ctx context.Context, navlink *v1.NavLink, opts metav1.CreateOptions) (result *v1.NavLink, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(navlinksResource, navlink), &v1.NavLink{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.NavLink), err
+}
+
+// Update takes the representation of a navlink and updates it. Returns the server's representation of the navlink, and an error, if there is any.
+func (c *FakeNavLinks) Update(ctx context.Context, navlink *v1.NavLink, opts metav1.UpdateOptions) (result *v1.NavLink, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateAction(navlinksResource, navlink), &v1.NavLink{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.NavLink), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeNavLinks) UpdateStatus(ctx context.Context, navlink *v1.NavLink, opts metav1.UpdateOptions) (*v1.NavLink, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateSubresourceAction(navlinksResource, "status", navlink), &v1.NavLink{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.NavLink), err
+}
+
+// Delete takes name of the navlink and deletes it. Returns an error if one occurs.
+func (c *FakeNavLinks) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteActionWithOptions(navlinksResource, name, opts), &v1.NavLink{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeNavLinks) DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionAction(navlinksResource, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1.NavLinkList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched navlink.
+func (c *FakeNavLinks) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *v1.NavLink, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(navlinksResource, name, pt, data, subresources...), &v1.NavLink{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.NavLink), err
+}
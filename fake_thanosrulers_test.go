@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+
+	v1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeThanosRulers implements ThanosRulerInterface
+type FakeThanosRulers struct {
+	Fake *FakeMonitoringV1
+	ns   string
+}
+
+var thanosrulersResource = v1.SchemeGroupVersion.WithResource("thanosrulers")
+
+var thanosrulersKind = v1.SchemeGroupVersion.WithKind("ThanosRuler")
+
+// Get takes name of the thanosruler, and returns the corresponding thanosruler object, and an error if there is any.
+func (c *FakeThanosRulers) Get(ctx context.Context, name string, options metav1.GetOptions) (result *v1.ThanosRuler, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(thanosrulersResource, c.ns, name), &v1.ThanosRuler{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.ThanosRuler), err
+}
+
+// List takes label and field selectors, and returns the list of ThanosRulers that match those selectors.
+func (c *FakeThanosRulers) List(ctx context.Context, opts metav1.ListOptions) (result *v1.ThanosRulerList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(thanosrulersResource, thanosrulersKind, c.ns, opts), &v1.ThanosRulerList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1.ThanosRulerList{ListMeta: obj.(*v1.ThanosRulerList).ListMeta}
+	for _, item := range obj.(*v1.ThanosRulerList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested thanosrulers.
+func (c *FakeThanosRulers) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(thanosrulersResource, c.ns, opts))
+}
+
+// Update takes the representation of a thanosruler and updates it. Returns the server's representation of the thanosruler, and an error, if there is any.
+func (c *FakeThanosRulers) Update(ctx context.Context, thanosruler *v1.ThanosRuler, opts metav1.UpdateOptions) (result *v1.ThanosRuler, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(thanosrulersResource, c.ns, thanosruler), &v1.ThanosRuler{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.ThanosRuler), err
+}
+
+// Patch applies the patch and returns the patched thanosruler.
+func (c *FakeThanosRulers) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *v1.ThanosRuler, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(thanosrulersResource, c.ns, name, pt, data, subresources...), &v1.ThanosRuler{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.ThanosRuler), err
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+	"k8s.io/client-go/testing"
+)
+
+// FakeUiV1 implements UiV1Interface with an in-memory object tracker
+type FakeUiV1 struct {
+	*testing.Fake
+}
+
+var _ UiV1Interface = &FakeUiV1{}
+
+func (c *FakeUiV1) NavLinks() NavLinkInterface {
+	return &FakeNavLinks{c}
+}
+
+// RESTClient returns a RESTClient that is used to communicate
+// with API server by this client implementation.
+func (c *FakeUiV1) RESTClient() rest.Interface {
+	var ret *rest.RESTClient
+	return ret
+}
+
+// NewSimpleFakeUiV1 returns a FakeUiV1 whose tracker is seeded with the given objects,
+// errors are injected with PrependReactor.
+func NewSimpleFakeUiV1(objects ...runtime.Object) *FakeUiV1 {
+	return &FakeUiV1{newFakeTracker(objects...)}
+}
+
+// newFakeTracker returns a testing.Fake reacting with an object tracker holding the objects
+func newFakeTracker(objects ...runtime.Object) *testing.Fake {
+	o := testing.NewObjectTracker(Scheme, Codecs.UniversalDecoder())
+	for _, obj := range objects {
+		if err := o.Add(obj); err != nil {
+			panic(err)
+		}
+	}
+
+	fake := &testing.Fake{}
+	fake.AddReactor("*", "*", testing.ObjectReaction(o))
+	fake.AddWatchReactor("*", func(action testing.Action) (handled bool, ret watch.Interface, err error) {
+		gvr := action.GetResource()
+		ns := action.GetNamespace()
+		watch, err := o.Watch(gvr, ns)
+		if err != nil {
+			return false, nil, err
+		}
+		return true, watch, nil
+	})
+	return fake
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"net/http"
+
+	rest "k8s.io/client-go/rest"
+)
+
+type GatewayV1Interface interface {
+	RESTClient() rest.Interface
+	HTTPRoutesGetter
+	GatewaysGetter
+}
+
+type HTTPRouteExpansion interface{}
+
+type GatewayExpansion interface{}
+
+// GatewayV1Client is used to interact with features provided by the gateway.networking.k8s.io group.
+type GatewayV1Client struct {
+	restClient rest.Interface
+}
+
+func (c *GatewayV1Client) HTTPRoutes(namespace string) HTTPRouteInterface {
+	return newHTTPRoutes(c, namespace)
+}
+
+func (c *GatewayV1Client) Gateways(namespace string) GatewayInterface {
+	return newGateways(c, namespace)
+}
+
+// NewGatewayForConfig creates a new GatewayV1Client for the given config.
+// NewGatewayForConfig is equivalent to NewGatewayForConfigAndClient(c, httpClient),
+// where httpClient was generated with rest.HTTPClientFor(c).
+func NewGatewayForConfig(c *rest.Config) (*GatewayV1Client, error) {
+	config := *c
+	if err := setGatewayConfigDefaults(&config); err != nil {
+		return nil, err
+	}
+	httpClient, err := rest.HTTPClientFor(&config)
+	if err != nil {
+		return nil, err
+	}
+	return NewGatewayForConfigAndClient(&config, httpClient)
+}
+
+// NewGatewayForConfigAndClient creates a new GatewayV1Client for the given config and http client.
+// Note the http client provided takes precedence over the configured transport values.
+func NewGatewayForConfigAndClient(c *rest.Config, h *http.Client) (*GatewayV1Client, error) {
+	config := *c
+	if err := setGatewayConfigDefaults(&config); err != nil {
+		return nil, err
+	}
+	client, err := rest.RESTClientForConfigAndClient(&config, h)
+	if err != nil {
+		return nil, err
+	}
+	return &GatewayV1Client{client}, nil
+}
+
+// NewGatewayForConfigOrDie creates a new GatewayV1Client for the given config and
+// panics if there is an error in the config.
+func NewGatewayForConfigOrDie(c *rest.Config) *GatewayV1Client {
+	client, err := NewGatewayForConfig(c)
+	if err != nil {
+		panic(err)
+	}
+	return client
+}
+
+// NewGateway creates a new GatewayV1Client for the given RESTClient.
+func NewGateway(c rest.Interface) *GatewayV1Client {
+	return &GatewayV1Client{c}
+}
+
+func setGatewayConfigDefaults(config *rest.Config) error {
+	gv := GatewaySchemeGroupVersion
+	config.GroupVersion = &gv
+	config.APIPath = "/apis"
+	config.NegotiatedSerializer = Codecs.WithoutConversion()
+
+	if config.UserAgent == "" {
+		config.UserAgent = rest.DefaultKubernetesUserAgent()
+	}
+
+	return nil
+}
+
+// RESTClient returns a RESTClient that is used to communicate
+// with API server by this client implementation.
+func (c *GatewayV1Client) RESTClient() rest.Interface {
+	if c == nil {
+		return nil
+	}
+	return c.restClient
+}
@@ -0,0 +1,266 @@
+package main
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// GatewaySchemeGroupVersion is the group version of the Gateway API resources
+var GatewaySchemeGroupVersion = schema.GroupVersion{Group: "gateway.networking.k8s.io", Version: "v1"}
+
+// HTTPRoute routes http requests of a Gateway to services, only the fields used for navlinks
+type HTTPRoute struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec HTTPRouteSpec `json:"spec,omitempty"`
+}
+
+// HTTPRouteSpec is the spec of a HTTPRoute
+type HTTPRouteSpec struct {
+	// ParentRefs are the Gateways the route is attached to
+	ParentRefs []ParentReference `json:"parentRefs,omitempty"`
+	// Hostnames are matched against the host header of the requests
+	Hostnames []string `json:"hostnames,omitempty"`
+	// Rules are the matches and backends of the route
+	Rules []HTTPRouteRule `json:"rules,omitempty"`
+}
+
+// ParentReference refers to a Gateway, and to one of its listeners with SectionName
+type ParentReference struct {
+	Group       string `json:"group,omitempty"`
+	Kind        string `json:"kind,omitempty"`
+	Namespace   string `json:"namespace,omitempty"`
+	Name        string `json:"name"`
+	SectionName string `json:"sectionName,omitempty"`
+}
+
+// HTTPRouteRule routes the matching requests to the backends
+type HTTPRouteRule struct {
+	Matches     []HTTPRouteMatch `json:"matches,omitempty"`
+	BackendRefs []HTTPBackendRef `json:"backendRefs,omitempty"`
+}
+
+// HTTPRouteMatch matches requests by path
+type HTTPRouteMatch struct {
+	Path *HTTPPathMatch `json:"path,omitempty"`
+}
+
+// HTTPPathMatch matches the request path, Type is Exact, PathPrefix or RegularExpression
+type HTTPPathMatch struct {
+	Type  string `json:"type,omitempty"`
+	Value string `json:"value,omitempty"`
+}
+
+// HTTPBackendRef refers to a backend of a rule, a Service when Group and Kind are empty
+type HTTPBackendRef struct {
+	Group     string `json:"group,omitempty"`
+	Kind      string `json:"kind,omitempty"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+	Port      int32  `json:"port,omitempty"`
+}
+
+// HTTPRouteList is a list of HTTPRoutes
+type HTTPRouteList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []HTTPRoute `json:"items"`
+}
+
+// Gateway is a load balancer of the Gateway API, only the fields used for navlinks
+type Gateway struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec GatewaySpec `json:"spec,omitempty"`
+}
+
+// GatewaySpec is the spec of a Gateway
+type GatewaySpec struct {
+	Listeners []Listener `json:"listeners"`
+}
+
+// Listener accepts connections of the protocol on the port, for the hostname when set
+type Listener struct {
+	Name     string `json:"name"`
+	Hostname string `json:"hostname,omitempty"`
+	Port     int32  `json:"port"`
+	Protocol string `json:"protocol"`
+}
+
+// GatewayList is a list of Gateways
+type GatewayList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []Gateway `json:"items"`
+}
+
+// addGatewayKnownTypes adds the gateway.networking.k8s.io types to the scheme
+func addGatewayKnownTypes(scheme *runtime.Scheme) error {
+	scheme.AddKnownTypes(GatewaySchemeGroupVersion,
+		&HTTPRoute{},
+		&HTTPRouteList{},
+		&Gateway{},
+		&GatewayList{},
+	)
+	metav1.AddToGroupVersion(scheme, GatewaySchemeGroupVersion)
+	return nil
+}
+
+// DeepCopyInto copies the receiver into out
+func (in *HTTPRouteSpec) DeepCopyInto(out *HTTPRouteSpec) {
+	*out = *in
+	if in.ParentRefs != nil {
+		out.ParentRefs = make([]ParentReference, len(in.ParentRefs))
+		copy(out.ParentRefs, in.ParentRefs)
+	}
+	if in.Hostnames != nil {
+		out.Hostnames = make([]string, len(in.Hostnames))
+		copy(out.Hostnames, in.Hostnames)
+	}
+	if in.Rules != nil {
+		out.Rules = make([]HTTPRouteRule, len(in.Rules))
+		for i := range in.Rules {
+			in.Rules[i].DeepCopyInto(&out.Rules[i])
+		}
+	}
+}
+
+// DeepCopyInto copies the receiver into out
+func (in *HTTPRouteRule) DeepCopyInto(out *HTTPRouteRule) {
+	*out = *in
+	if in.Matches != nil {
+		out.Matches = make([]HTTPRouteMatch, len(in.Matches))
+		for i := range in.Matches {
+			if in.Matches[i].Path != nil {
+				path := *in.Matches[i].Path
+				out.Matches[i].Path = &path
+			}
+		}
+	}
+	if in.BackendRefs != nil {
+		out.BackendRefs = make([]HTTPBackendRef, len(in.BackendRefs))
+		copy(out.BackendRefs, in.BackendRefs)
+	}
+}
+
+// DeepCopyInto copies the receiver into out
+func (in *HTTPRoute) DeepCopyInto(out *HTTPRoute) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy copies the receiver into a new HTTPRoute
+func (in *HTTPRoute) DeepCopy() *HTTPRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject copies the receiver into a new runtime.Object
+func (in *HTTPRoute) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto copies the receiver into out
+func (in *HTTPRouteList) DeepCopyInto(out *HTTPRouteList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		out.Items = make([]HTTPRoute, len(in.Items))
+		for i := range in.Items {
+			in.Items[i].DeepCopyInto(&out.Items[i])
+		}
+	}
+}
+
+// DeepCopy copies the receiver into a new HTTPRouteList
+func (in *HTTPRouteList) DeepCopy() *HTTPRouteList {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPRouteList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject copies the receiver into a new runtime.Object
+func (in *HTTPRouteList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto copies the receiver into out
+func (in *Gateway) DeepCopyInto(out *Gateway) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Spec.Listeners != nil {
+		out.Spec.Listeners = make([]Listener, len(in.Spec.Listeners))
+		copy(out.Spec.Listeners, in.Spec.Listeners)
+	}
+}
+
+// DeepCopy copies the receiver into a new Gateway
+func (in *Gateway) DeepCopy() *Gateway {
+	if in == nil {
+		return nil
+	}
+	out := new(Gateway)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject copies the receiver into a new runtime.Object
+func (in *Gateway) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto copies the receiver into out
+func (in *GatewayList) DeepCopyInto(out *GatewayList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		out.Items = make([]Gateway, len(in.Items))
+		for i := range in.Items {
+			in.Items[i].DeepCopyInto(&out.Items[i])
+		}
+	}
+}
+
+// DeepCopy copies the receiver into a new GatewayList
+func (in *GatewayList) DeepCopy() *GatewayList {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject copies the receiver into a new runtime.Object
+func (in *GatewayList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
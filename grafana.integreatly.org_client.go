@@ -0,0 +1,89 @@
+package main
+
+import (
+	"net/http"
+
+	rest "k8s.io/client-go/rest"
+)
+
+type GrafanaV1beta1Interface interface {
+	RESTClient() rest.Interface
+	GrafanasGetter
+}
+
+type GrafanaExpansion interface{}
+
+// GrafanaV1beta1Client is used to interact with features provided by the grafana.integreatly.org group.
+type GrafanaV1beta1Client struct {
+	restClient rest.Interface
+}
+
+func (c *GrafanaV1beta1Client) Grafanas(namespace string) GrafanaInterface {
+	return newGrafanas(c, namespace)
+}
+
+// NewGrafanaForConfig creates a new GrafanaV1beta1Client for the given config.
+// NewGrafanaForConfig is equivalent to NewGrafanaForConfigAndClient(c, httpClient),
+// where httpClient was generated with rest.HTTPClientFor(c).
+func NewGrafanaForConfig(c *rest.Config) (*GrafanaV1beta1Client, error) {
+	config := *c
+	if err := setGrafanaConfigDefaults(&config); err != nil {
+		return nil, err
+	}
+	httpClient, err := rest.HTTPClientFor(&config)
+	if err != nil {
+		return nil, err
+	}
+	return NewGrafanaForConfigAndClient(&config, httpClient)
+}
+
+// NewGrafanaForConfigAndClient creates a new GrafanaV1beta1Client for the given config and http client.
+// Note the http client provided takes precedence over the configured transport values.
+func NewGrafanaForConfigAndClient(c *rest.Config, h *http.Client) (*GrafanaV1beta1Client, error) {
+	config := *c
+	if err := setGrafanaConfigDefaults(&config); err != nil {
+		return nil, err
+	}
+	client, err := rest.RESTClientForConfigAndClient(&config, h)
+	if err != nil {
+		return nil, err
+	}
+	return &GrafanaV1beta1Client{client}, nil
+}
+
+// NewGrafanaForConfigOrDie creates a new GrafanaV1beta1Client for the given config and
+// panics if there is an error in the config.
+func NewGrafanaForConfigOrDie(c *rest.Config) *GrafanaV1beta1Client {
+	client, err := NewGrafanaForConfig(c)
+	if err != nil {
+		panic(err)
+	}
+	return client
+}
+
+// NewGrafana creates a new GrafanaV1beta1Client for the given RESTClient.
+func NewGrafana(c rest.Interface) *GrafanaV1beta1Client {
+	return &GrafanaV1beta1Client{c}
+}
+
+func setGrafanaConfigDefaults(config *rest.Config) error {
+	gv := GrafanaSchemeGroupVersion
+	config.GroupVersion = &gv
+	config.APIPath = "/apis"
+	config.NegotiatedSerializer = Codecs.WithoutConversion()
+
+	if config.UserAgent == "" {
+		config.UserAgent = rest.DefaultKubernetesUserAgent()
+	}
+
+	return nil
+}
+
+// RESTClient returns a RESTClient that is used to communicate
+// with API server by this client implementation.
+func (c *GrafanaV1beta1Client) RESTClient() rest.Interface {
+	if c == nil {
+		return nil
+	}
+	return c.restClient
+}
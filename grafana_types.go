@@ -0,0 +1,128 @@
+package main
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// GrafanaSchemeGroupVersion is the group version of the Grafana Operator resources
+var GrafanaSchemeGroupVersion = schema.GroupVersion{Group: "grafana.integreatly.org", Version: "v1beta1"}
+
+const grafanaKind = "Grafana"
+
+// Grafana is a Grafana instance of the Grafana Operator, only the fields used for navlinks
+type Grafana struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec GrafanaSpec `json:"spec,omitempty"`
+}
+
+// GrafanaSpec is the spec of a Grafana instance
+type GrafanaSpec struct {
+	// Config is the grafana.ini by section and key
+	Config map[string]map[string]string `json:"config,omitempty"`
+	// External is a Grafana instance not managed by the operator
+	External *GrafanaExternal `json:"external,omitempty"`
+}
+
+// GrafanaExternal is a Grafana instance not managed by the operator
+type GrafanaExternal struct {
+	// URL of the Grafana instance
+	URL string `json:"url"`
+}
+
+// GrafanaList is a list of Grafanas
+type GrafanaList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []Grafana `json:"items"`
+}
+
+// addGrafanaKnownTypes adds the grafana.integreatly.org types to the scheme
+func addGrafanaKnownTypes(scheme *runtime.Scheme) error {
+	scheme.AddKnownTypes(GrafanaSchemeGroupVersion,
+		&Grafana{},
+		&GrafanaList{},
+	)
+	metav1.AddToGroupVersion(scheme, GrafanaSchemeGroupVersion)
+	return nil
+}
+
+// DeepCopyInto copies the receiver into out
+func (in *GrafanaSpec) DeepCopyInto(out *GrafanaSpec) {
+	*out = *in
+	if in.Config != nil {
+		out.Config = make(map[string]map[string]string, len(in.Config))
+		for section, values := range in.Config {
+			copied := make(map[string]string, len(values))
+			for key, value := range values {
+				copied[key] = value
+			}
+			out.Config[section] = copied
+		}
+	}
+	if in.External != nil {
+		external := *in.External
+		out.External = &external
+	}
+}
+
+// DeepCopyInto copies the receiver into out
+func (in *Grafana) DeepCopyInto(out *Grafana) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy copies the receiver into a new Grafana
+func (in *Grafana) DeepCopy() *Grafana {
+	if in == nil {
+		return nil
+	}
+	out := new(Grafana)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject copies the receiver into a new runtime.Object
+func (in *Grafana) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto copies the receiver into out
+func (in *GrafanaList) DeepCopyInto(out *GrafanaList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		out.Items = make([]Grafana, len(in.Items))
+		for i := range in.Items {
+			in.Items[i].DeepCopyInto(&out.Items[i])
+		}
+	}
+}
+
+// DeepCopy copies the receiver into a new GrafanaList
+func (in *GrafanaList) DeepCopy() *GrafanaList {
+	if in == nil {
+		return nil
+	}
+	out := new(GrafanaList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject copies the receiver into a new runtime.Object
+func (in *GrafanaList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
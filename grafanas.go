@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	watch "k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes/scheme"
+	rest "k8s.io/client-go/rest"
+)
+
+// GrafanasGetter has a method to return a GrafanaInterface.
+// A group's client should implement this interface.
+type GrafanasGetter interface {
+	Grafanas(namespace string) GrafanaInterface
+}
+
+// GrafanaInterface has methods to work with Grafana resources.
+type GrafanaInterface interface {
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (*Grafana, error)
+	List(ctx context.Context, opts metav1.ListOptions) (*GrafanaList, error)
+	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+	GrafanaExpansion
+}
+
+// grafanas implements GrafanaInterface
+type grafanas struct {
+	client rest.Interface
+	ns     string
+}
+
+// newGrafanas returns a Grafanas
+func newGrafanas(c *GrafanaV1beta1Client, namespace string) *grafanas {
+	return &grafanas{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the grafana, and returns the corresponding grafana object, and an error if there is any.
+func (c *grafanas) Get(ctx context.Context, name string, options metav1.GetOptions) (result *Grafana, err error) {
+	result = &Grafana{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("grafanas").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of Grafanas that match those selectors.
+func (c *grafanas) List(ctx context.Context, opts metav1.ListOptions) (result *GrafanaList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &GrafanaList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("grafanas").
+		VersionedParams(&opts, ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested grafanas.
+func (c *grafanas) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("grafanas").
+		VersionedParams(&opts, ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
@@ -9,8 +9,15 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/golang/glog"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	discoveryv1client "k8s.io/client-go/kubernetes/typed/discovery/v1"
+	networkingv1client "k8s.io/client-go/kubernetes/typed/networking/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -22,9 +29,30 @@ const (
 	mport = "8081"
 )
 
+// templateSyncTimeout is the time waited for the NavLinkTemplates before the first backfill
+const templateSyncTimeout = 30 * time.Second
+
+const (
+	modeWebhook    = "webhook"
+	modeController = "controller"
+	modeAll        = "all"
+)
+
 var (
-	tlscert, tlskey string
-	opsProcessed    = promauto.NewCounter(prometheus.CounterOpts{
+	tlscert, tlskey  string
+	mode             string
+	configFile       string
+	backfill         bool
+	backfillInterval time.Duration
+	finalizer        bool
+	leaderElect      bool
+	leaderNamespace  string
+	leaderID         string
+	navlinkTemplates bool
+	discoverServices bool
+	requireReady     bool
+	writeWorkers     int
+	opsProcessed     = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "navlinks_processed_ops_total",
 		Help: "The total number of processed events",
 	})
@@ -33,27 +61,111 @@ var (
 func main() {
 	flag.StringVar(&tlscert, "tlsCertFile", "/etc/certs/tls.crt", "File containing the x509 Certificate for HTTPS.")
 	flag.StringVar(&tlskey, "tlsKeyFile", "/etc/certs/tls.key", "File containing the x509 private key to --tlsCertFile.")
+	flag.StringVar(&mode, "mode", modeWebhook, "Run mode: webhook, controller or all (webhook and controller).")
+	flag.StringVar(&configFile, "config", "", "File containing the link definitions in YAML or JSON, default Prometheus and Grafana.")
+	flag.BoolVar(&backfill, "backfill", true, "Create missing and delete orphaned navlinks for all Prometheus on startup.")
+	flag.DurationVar(&backfillInterval, "backfillInterval", time.Hour, "Interval to repeat the backfill, 0 to run it only on startup.")
+	flag.BoolVar(&finalizer, "finalizer", false, "Place a finalizer on Prometheus objects, removed after their navlinks are deleted (controller mode).")
+	flag.BoolVar(&leaderElect, "leaderElect", true, "Run controller and backfill only on the replica holding the leader lease.")
+	flag.StringVar(&leaderNamespace, "leaderElectionNamespace", os.Getenv("POD_NAMESPACE"), "Namespace of the leader election lease.")
+	flag.StringVar(&leaderID, "leaderElectionID", "navlinkswebhook", "Name of the leader election lease.")
+
+	flag.BoolVar(&navlinkTemplates, "navlinkTemplates", true, "Watch NavLinkTemplate resources and create their navlinks for matching Prometheus.")
+	flag.BoolVar(&discoverServices, "discoverServices", true, "Link the Services and ports selecting the pods of Prometheus, Alertmanager and ThanosRuler.")
+	flag.BoolVar(&requireReady, "requireReadyService", false, "Create navlinks only for services with a ready endpoint, watched by the controller.")
+	flag.IntVar(&writeWorkers, "writeWorkers", 4, "Number of workers resolving and of workers writing the navlinks queued by admission requests.")
 
 	flag.Parse()
 
-	certs, err := tls.LoadX509KeyPair(tlscert, tlskey)
+	if mode != modeWebhook && mode != modeController && mode != modeAll {
+		glog.Fatalf("Unknown mode %q, must be one of %s, %s, %s", mode, modeWebhook, modeController, modeAll)
+	}
+	runWebhook := mode == modeWebhook || mode == modeAll
+	runController := mode == modeController || mode == modeAll
+	runBackground := runController || backfill
+	if finalizer && !runController {
+		glog.Fatalf("Finalizer requires mode %s or %s", modeController, modeAll)
+	}
+	// only the controller or a repeated backfill create the pending navlinks
+	if requireReady && !runController && (!backfill || backfillInterval == 0) {
+		glog.Fatalf("requireReadyService requires mode %s or %s, or a backfill with backfillInterval", modeController, modeAll)
+	}
+	if writeWorkers < 1 {
+		glog.Fatalf("Invalid writeWorkers %d, must be at least 1", writeWorkers)
+	}
+
+	config, err := loadConfig(configFile)
 	if err != nil {
-		glog.Errorf("Filed to load key pair: %v", err)
+		glog.Fatalf("Failed to load config: %v", err)
 	}
 
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
 	server := &http.Server{
-		Addr:      fmt.Sprintf(":%v", port),
-		TLSConfig: &tls.Config{Certificates: []tls.Certificate{certs}},
+		Addr: fmt.Sprintf(":%v", port),
 	}
 
 	mserver := &http.Server{
 		Addr: fmt.Sprintf(":%v", mport),
 	}
 
+	// creates the in-cluster config and the clients shared by webhook and controller
+	restConfig, err := rest.InClusterConfig()
+	if err != nil {
+		glog.Fatalf("Failed to get InCluster config: %v", err)
+	}
+	navlinks := NewForConfigOrDie(restConfig).Navlinks()
+	core := corev1client.NewForConfigOrDie(restConfig)
+	sources := &sourceClients{
+		monitoring: NewMonitoringForConfigOrDie(restConfig),
+		grafana:    NewGrafanaForConfigOrDie(restConfig),
+		services:   core,
+	}
+	builder, err := newNavlinkBuilder(config, core)
+	if err != nil {
+		glog.Fatalf("Failed to load naming templates: %v", err)
+	}
+	builder.routes = &routeClients{
+		ingresses: networkingv1client.NewForConfigOrDie(restConfig),
+		gateway:   NewGatewayForConfigOrDie(restConfig),
+	}
+	if discoverServices {
+		builder.services = core
+	}
+	if requireReady {
+		builder.gate = newServiceGate(core, discoveryv1client.NewForConfigOrDie(restConfig))
+		go builder.gate.Run(ctx)
+	}
+	var templateInformer cache.SharedIndexInformer
+	if navlinkTemplates {
+		templateInformer = newNavlinkTemplateInformer(NewNavlinksForConfigOrDie(restConfig).NavLinkTemplates())
+		builder.templates = templateInformer.GetStore()
+		go templateInformer.Run(ctx.Done())
+	}
+
+	// check if navlink resource is available on api server
+	if _, err := navlinks.List(ctx, metav1.ListOptions{Limit: 1}); err != nil {
+		glog.Error("navlinks resource not available: ", err)
+	}
+
+	var leader *leaderStatus
+	if runBackground && leaderElect {
+		identity, err := os.Hostname()
+		if err != nil {
+			glog.Fatalf("Failed to get hostname for leader election: %v", err)
+		}
+		if podName := os.Getenv("POD_NAME"); podName != "" {
+			identity = podName
+		}
+		leader = &leaderStatus{identity: identity}
+	}
+
 	// define http server and server handler
-	nls := NavlinksServerHandler{}
+	nls := NewNavlinksServerHandler(navlinks, sources, builder, leader)
 	mux := http.NewServeMux()
 	mux.HandleFunc("/validate", nls.serve)
+	mux.HandleFunc("/mutate", nls.mutate)
 	server.Handler = mux
 
 	mmux := http.NewServeMux()
@@ -62,18 +174,77 @@ func main() {
 	mserver.Handler = mmux
 
 	// start webhook server in new rountine
-	go func() {
-		if err := server.ListenAndServeTLS("", ""); err != nil {
-			glog.Errorf("Failed to listen and serve webhook server: %v", err)
+	if runWebhook {
+		// navlinks of admission requests are resolved, written and retried on every replica
+		go nls.changes.Run(ctx, writeWorkers)
+		go nls.writes.Run(ctx, writeWorkers)
+		certs, err := tls.LoadX509KeyPair(tlscert, tlskey)
+		if err != nil {
+			glog.Errorf("Filed to load key pair: %v", err)
 		}
-	}()
+		server.TLSConfig = &tls.Config{Certificates: []tls.Certificate{certs}}
+
+		go func() {
+			if err := server.ListenAndServeTLS("", ""); err != nil {
+				glog.Errorf("Failed to listen and serve webhook server: %v", err)
+			}
+		}()
+	}
 	go func() {
 		if err := mserver.ListenAndServe(); err != nil {
 			glog.Errorf("Failed to listen and serve minitor server: %v", err)
 		}
 	}()
 
-	glog.Infof("Server running listening in port: %s,%s", port, mport)
+	// start controller and backfill in new routines
+	if runBackground {
+		background := func(ctx context.Context) {
+			if templateInformer != nil {
+				syncCtx, syncCancel := context.WithTimeout(ctx, templateSyncTimeout)
+				if !cache.WaitForCacheSync(syncCtx.Done(), templateInformer.HasSynced) {
+					glog.Error("NavLinkTemplates not synced, navlinks of templates are created once they are")
+				}
+				syncCancel()
+			}
+			// template changes are reconciled by the controller, or else by the backfill
+			var trigger chan struct{}
+			if backfill {
+				if templateInformer != nil && !runController {
+					trigger = make(chan struct{}, 1)
+					notify := func() {
+						select {
+						case trigger <- struct{}{}:
+						default:
+						}
+					}
+					if err := onTemplateChange(ctx, templateInformer, notify); err != nil {
+						glog.Errorf("Failed to watch NavLinkTemplates: %v", err)
+					}
+				}
+				go runBackfill(ctx, navlinks, sources, builder, backfillInterval, trigger)
+			}
+			if runController {
+				controller := NewNavlinksController(navlinks, sources, builder, finalizer)
+				if templateInformer != nil {
+					if err := onTemplateChange(ctx, templateInformer, controller.enqueueAll); err != nil {
+						glog.Errorf("Failed to watch NavLinkTemplates: %v", err)
+					}
+				}
+				controller.Run(ctx)
+			}
+			<-ctx.Done()
+		}
+		if leaderElect {
+			if leaderNamespace == "" {
+				glog.Fatal("Leader election namespace not set, use -leaderElectionNamespace or POD_NAMESPACE")
+			}
+			go runLeaderElection(ctx, restConfig, leaderNamespace, leaderID, leader, background)
+		} else {
+			go background(ctx)
+		}
+	}
+
+	glog.Infof("Server running in mode %s listening in port: %s,%s", mode, port, mport)
 
 	// listening shutdown singal
 	signalChan := make(chan os.Signal, 1)
@@ -81,6 +252,11 @@ func main() {
 	<-signalChan
 
 	glog.Info("Got shutdown signal, shutting down webhook server gracefully...")
+	// no more admission requests, the writes of the served ones are finished before the workers are stopped
 	server.Shutdown(context.Background())
+	if runWebhook {
+		nls.drain(writeDrainTimeout)
+	}
+	cancel()
 	mserver.Shutdown(context.Background())
 }
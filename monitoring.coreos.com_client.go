@@ -0,0 +1,104 @@
+package main
+
+import (
+	"net/http"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	rest "k8s.io/client-go/rest"
+)
+
+type MonitoringV1Interface interface {
+	RESTClient() rest.Interface
+	PrometheusesGetter
+	AlertmanagersGetter
+	ThanosRulersGetter
+}
+
+type PrometheusExpansion interface{}
+
+type AlertmanagerExpansion interface{}
+
+type ThanosRulerExpansion interface{}
+
+// MonitoringV1Client is used to interact with features provided by the monitoring.coreos.com group.
+type MonitoringV1Client struct {
+	restClient rest.Interface
+}
+
+func (c *MonitoringV1Client) Prometheuses(namespace string) PrometheusInterface {
+	return newPrometheuses(c, namespace)
+}
+
+func (c *MonitoringV1Client) Alertmanagers(namespace string) AlertmanagerInterface {
+	return newAlertmanagers(c, namespace)
+}
+
+func (c *MonitoringV1Client) ThanosRulers(namespace string) ThanosRulerInterface {
+	return newThanosRulers(c, namespace)
+}
+
+// NewMonitoringForConfig creates a new MonitoringV1Client for the given config.
+// NewMonitoringForConfig is equivalent to NewMonitoringForConfigAndClient(c, httpClient),
+// where httpClient was generated with rest.HTTPClientFor(c).
+func NewMonitoringForConfig(c *rest.Config) (*MonitoringV1Client, error) {
+	config := *c
+	if err := setMonitoringConfigDefaults(&config); err != nil {
+		return nil, err
+	}
+	httpClient, err := rest.HTTPClientFor(&config)
+	if err != nil {
+		return nil, err
+	}
+	return NewMonitoringForConfigAndClient(&config, httpClient)
+}
+
+// NewMonitoringForConfigAndClient creates a new MonitoringV1Client for the given config and http client.
+// Note the http client provided takes precedence over the configured transport values.
+func NewMonitoringForConfigAndClient(c *rest.Config, h *http.Client) (*MonitoringV1Client, error) {
+	config := *c
+	if err := setMonitoringConfigDefaults(&config); err != nil {
+		return nil, err
+	}
+	client, err := rest.RESTClientForConfigAndClient(&config, h)
+	if err != nil {
+		return nil, err
+	}
+	return &MonitoringV1Client{client}, nil
+}
+
+// NewMonitoringForConfigOrDie creates a new MonitoringV1Client for the given config and
+// panics if there is an error in the config.
+func NewMonitoringForConfigOrDie(c *rest.Config) *MonitoringV1Client {
+	client, err := NewMonitoringForConfig(c)
+	if err != nil {
+		panic(err)
+	}
+	return client
+}
+
+// NewMonitoring creates a new MonitoringV1Client for the given RESTClient.
+func NewMonitoring(c rest.Interface) *MonitoringV1Client {
+	return &MonitoringV1Client{c}
+}
+
+func setMonitoringConfigDefaults(config *rest.Config) error {
+	gv := monitoringv1.SchemeGroupVersion
+	config.GroupVersion = &gv
+	config.APIPath = "/apis"
+	config.NegotiatedSerializer = Codecs.WithoutConversion()
+
+	if config.UserAgent == "" {
+		config.UserAgent = rest.DefaultKubernetesUserAgent()
+	}
+
+	return nil
+}
+
+// RESTClient returns a RESTClient that is used to communicate
+// with API server by this client implementation.
+func (c *MonitoringV1Client) RESTClient() rest.Interface {
+	if c == nil {
+		return nil
+	}
+	return c.restClient
+}
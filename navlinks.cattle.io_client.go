@@ -0,0 +1,89 @@
+package main
+
+import (
+	"net/http"
+
+	rest "k8s.io/client-go/rest"
+)
+
+type NavlinksV1alpha1Interface interface {
+	RESTClient() rest.Interface
+	NavLinkTemplatesGetter
+}
+
+type NavLinkTemplateExpansion interface{}
+
+// NavlinksV1alpha1Client is used to interact with features provided by the navlinks.cattle.io group.
+type NavlinksV1alpha1Client struct {
+	restClient rest.Interface
+}
+
+func (c *NavlinksV1alpha1Client) NavLinkTemplates() NavLinkTemplateInterface {
+	return newNavLinkTemplates(c)
+}
+
+// NewNavlinksForConfig creates a new NavlinksV1alpha1Client for the given config.
+// NewNavlinksForConfig is equivalent to NewNavlinksForConfigAndClient(c, httpClient),
+// where httpClient was generated with rest.HTTPClientFor(c).
+func NewNavlinksForConfig(c *rest.Config) (*NavlinksV1alpha1Client, error) {
+	config := *c
+	if err := setNavlinksConfigDefaults(&config); err != nil {
+		return nil, err
+	}
+	httpClient, err := rest.HTTPClientFor(&config)
+	if err != nil {
+		return nil, err
+	}
+	return NewNavlinksForConfigAndClient(&config, httpClient)
+}
+
+// NewNavlinksForConfigAndClient creates a new NavlinksV1alpha1Client for the given config and http client.
+// Note the http client provided takes precedence over the configured transport values.
+func NewNavlinksForConfigAndClient(c *rest.Config, h *http.Client) (*NavlinksV1alpha1Client, error) {
+	config := *c
+	if err := setNavlinksConfigDefaults(&config); err != nil {
+		return nil, err
+	}
+	client, err := rest.RESTClientForConfigAndClient(&config, h)
+	if err != nil {
+		return nil, err
+	}
+	return &NavlinksV1alpha1Client{client}, nil
+}
+
+// NewNavlinksForConfigOrDie creates a new NavlinksV1alpha1Client for the given config and
+// panics if there is an error in the config.
+func NewNavlinksForConfigOrDie(c *rest.Config) *NavlinksV1alpha1Client {
+	client, err := NewNavlinksForConfig(c)
+	if err != nil {
+		panic(err)
+	}
+	return client
+}
+
+// NewNavlinks creates a new NavlinksV1alpha1Client for the given RESTClient.
+func NewNavlinks(c rest.Interface) *NavlinksV1alpha1Client {
+	return &NavlinksV1alpha1Client{c}
+}
+
+func setNavlinksConfigDefaults(config *rest.Config) error {
+	gv := NavlinksSchemeGroupVersion
+	config.GroupVersion = &gv
+	config.APIPath = "/apis"
+	config.NegotiatedSerializer = Codecs.WithoutConversion()
+
+	if config.UserAgent == "" {
+		config.UserAgent = rest.DefaultKubernetesUserAgent()
+	}
+
+	return nil
+}
+
+// RESTClient returns a RESTClient that is used to communicate
+// with API server by this client implementation.
+func (c *NavlinksV1alpha1Client) RESTClient() rest.Interface {
+	if c == nil {
+		return nil
+	}
+	return c.restClient
+}
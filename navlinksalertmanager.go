@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	uiv1 "github.com/rancher/rancher/pkg/apis/ui.cattle.io/v1"
+)
+
+// alertmanagerLink is the link to the service the Prometheus Operator creates for Alertmanagers
+func (c *Config) alertmanagerLink(am *monitoringv1.Alertmanager) LinkConfig {
+	port := am.Spec.PortName
+	if port == "" {
+		port = "web"
+	}
+	icon := c.Alertmanager.Icon
+	if icon == "" {
+		icon = "alertmanager"
+	}
+	return LinkConfig{
+		Name:    "alertmanager",
+		Service: "alertmanager-operated",
+		Port:    port,
+		Icon:    icon,
+		Label:   c.Alertmanager.Label,
+		Access:  c.Alertmanager.Access,
+		Scheme:  linkScheme(c.Alertmanager.Scheme, am.Spec.Web != nil && am.Spec.Web.TLSConfig != nil),
+	}
+}
+
+// alertmanagerNavlinks returns the navlink of an Alertmanager object, to its externalUrl if set
+// or under its routePrefix, overridden by the annotations of the Alertmanager
+func (b *navlinkBuilder) alertmanagerNavlinks(ctx context.Context, am *monitoringv1.Alertmanager) ([]uiv1.NavLink, error) {
+	if b.config.Alertmanager.Disabled {
+		return nil, nil
+	}
+	navlinks, err := b.resourceNavlinks(ctx, am, monitoringv1.SchemeGroupVersion.String(), monitoringv1.AlertmanagersKind, b.config.alertmanagerLink(am))
+	if err != nil || len(navlinks) == 0 {
+		return nil, err
+	}
+	setWebRoute(&navlinks[0], am.Spec.ExternalURL, am.Spec.RoutePrefix)
+	return navlinks, nil
+}
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	uiv1 "github.com/rancher/rancher/pkg/apis/ui.cattle.io/v1"
+	v1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func testAlertmanager(namespace string, name string) *monitoringv1.Alertmanager {
+	return &monitoringv1.Alertmanager{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+			UID:       "alertmanager-uid",
+		},
+	}
+}
+
+func TestServeAlertmanager(t *testing.T) {
+	external := testAlertmanager("team", "main")
+	external.Spec.ExternalURL = "https://alerts.example.com"
+	custom := testAlertmanager("team", "main")
+	custom.Spec.PortName = "http"
+
+	tests := []struct {
+		name         string
+		operation    v1.Operation
+		alertmanager *monitoringv1.Alertmanager
+		navlinks     []runtime.Object
+		message      string
+		wantNavlink  []string
+		wantURL      string
+		wantPort     string
+	}{
+		{
+			name:         "create",
+			operation:    v1.Create,
+			alertmanager: testAlertmanager("team", "main"),
+			message:      "Navlinks create",
+			wantNavlink:  []string{"monitoring-team-alertmanager-operated"},
+			wantPort:     "web",
+		},
+		{
+			name:         "create with port name",
+			operation:    v1.Create,
+			alertmanager: custom,
+			message:      "Navlinks create",
+			wantNavlink:  []string{"monitoring-team-alertmanager-operated"},
+			wantPort:     "http",
+		},
+		{
+			name:         "create with external url",
+			operation:    v1.Create,
+			alertmanager: external,
+			message:      "Navlinks create",
+			wantNavlink:  []string{"monitoring-team-alertmanager-operated"},
+			wantURL:      "https://alerts.example.com",
+		},
+		{
+			name:         "delete keeps prometheus navlinks",
+			operation:    v1.Delete,
+			alertmanager: testAlertmanager("team", "main"),
+			navlinks: []runtime.Object{
+				testNavlink("team", "prometheus-operated"),
+				testAlertmanagerNavlink(t, testAlertmanager("team", "main")),
+			},
+			message:     "Navlinks delete",
+			wantNavlink: []string{"monitoring-team-prometheus-operated"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			navlinks := NewSimpleFakeUiV1(tt.navlinks...)
+			nls := NewNavlinksServerHandler(navlinks.NavLinks(), newFakeSources(), testBuilder(t, defaultConfig()), nil)
+
+			body := testAdmissionReview(t, tt.operation, false, tt.alertmanager)
+			req := httptest.NewRequest(http.MethodPost, "/validate", bytes.NewReader(body))
+			rec := httptest.NewRecorder()
+			nls.serve(rec, req)
+			drainWrites(t, nls)
+
+			resp := v1.AdmissionReview{}
+			if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+				t.Fatal(err)
+			}
+			if !resp.Response.Allowed || resp.Response.Result.Message != tt.message {
+				t.Errorf("allowed = %t, message = %q, want %q", resp.Response.Allowed, resp.Response.Result.Message, tt.message)
+			}
+			if names := navlinkNames(t, navlinks); !reflect.DeepEqual(names, tt.wantNavlink) {
+				t.Fatalf("navlinks = %v, want %v", names, tt.wantNavlink)
+			}
+			if tt.operation != v1.Create {
+				return
+			}
+			nl, err := navlinks.NavLinks().Get(req.Context(), tt.wantNavlink[0], metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if nl.Spec.ToURL != tt.wantURL {
+				t.Errorf("toURL = %q, want %q", nl.Spec.ToURL, tt.wantURL)
+			}
+			if tt.wantPort != "" && nl.Spec.ToService.Port.String() != tt.wantPort {
+				t.Errorf("port = %s, want %s", nl.Spec.ToService.Port.String(), tt.wantPort)
+			}
+			if nl.Labels[sourceKindLabel] != monitoringv1.AlertmanagersKind {
+				t.Errorf("source kind = %q", nl.Labels[sourceKindLabel])
+			}
+		})
+	}
+}
+
+func testAlertmanagerNavlink(t *testing.T, am *monitoringv1.Alertmanager) *uiv1.NavLink {
+	t.Helper()
+	navlinks, err := testBuilder(t, defaultConfig()).alertmanagerNavlinks(context.Background(), am)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &navlinks[0]
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+
+	uiv1 "github.com/rancher/rancher/pkg/apis/ui.cattle.io/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// legacyOwnerName is the owner name older versions set on navlinks, it recognizes
+// navlinks created before they were labeled
+const legacyOwnerName = "valinkswebhook"
+
+// runBackfill runs the backfill once and then every interval and on each trigger until the
+// context is done, an interval of zero and no trigger runs it only once
+func runBackfill(ctx context.Context, navlinks NavLinkInterface, sources *sourceClients, builder *navlinkBuilder, interval time.Duration, trigger <-chan struct{}) {
+	for {
+		if err := backfillNavlinks(ctx, navlinks, sources, builder); err != nil {
+			glog.Errorf("error backfilling navlinks: %v", err)
+		}
+		var tick <-chan time.Time
+		if interval > 0 {
+			tick = time.After(interval)
+		} else if trigger == nil {
+			return
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-tick:
+		case <-trigger:
+		}
+	}
+}
+
+// backfillNavlinks creates the missing navlinks for all source objects in the cluster
+// and deletes the managed navlinks whose source does not exist anymore. The navlinks are
+// listed before the sources, so navlinks written for sources created meanwhile are kept.
+func backfillNavlinks(ctx context.Context, navlinks NavLinkInterface, sources *sourceClients, builder *navlinkBuilder) error {
+	navlinkList, err := navlinks.List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("listing navlinks: %w", err)
+	}
+
+	kinds, err := sources.availableKinds(ctx, builder.config.sourceKinds())
+	if err != nil {
+		return err
+	}
+	var objs []metav1.Object
+	for _, kind := range kinds {
+		list, err := sources.listSources(ctx, kind, metav1.NamespaceAll, metav1.ListOptions{})
+		if err != nil {
+			return fmt.Errorf("listing %s: %w", kind, err)
+		}
+		objs = append(objs, list...)
+	}
+
+	desired, failed := builder.desiredNavlinks(ctx, objs)
+	for ns, err := range failed {
+		glog.Errorf("error building navlinks for %s, kept as they are: %v", ns, err)
+	}
+
+	var current []uiv1.NavLink
+	for _, nl := range navlinkList.Items {
+		if isManaged(&nl) && failed[nl.Labels[sourceNamespaceLabel]] == nil {
+			current = append(current, nl)
+		}
+	}
+
+	glog.Infof("backfilling navlinks for %d sources, %d managed navlinks found", len(objs), len(current))
+	if err := syncNavlinks(ctx, navlinks, desired, current); err != nil {
+		return err
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("building navlinks failed for %d namespaces", len(failed))
+	}
+	return nil
+}
+
+// isManaged reports whether the navlink was created by the webhook, either labeled
+// or with the owner reference of older versions
+func isManaged(nl *uiv1.NavLink) bool {
+	if nl.Labels[managedByLabel] == managedBy {
+		return true
+	}
+	for _, ref := range nl.OwnerReferences {
+		if ref.Kind == "Prometheus" && ref.Name == legacyOwnerName {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	uiv1 "github.com/rancher/rancher/pkg/apis/ui.cattle.io/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestBackfillNavlinks(t *testing.T) {
+	invalid := testPrometheus("broken", "prometheus")
+	invalid.Annotations = map[string]string{overridePrefix + "grafana-service": "grafana.other"}
+	legacy := &uiv1.NavLink{ObjectMeta: metav1.ObjectMeta{
+		Name:            "monitoring-gone-prometheus-operated",
+		OwnerReferences: []metav1.OwnerReference{{Kind: "Prometheus", Name: legacyOwnerName}},
+	}}
+	unmanaged := &uiv1.NavLink{ObjectMeta: metav1.ObjectMeta{Name: "rancher-docs"}}
+
+	tests := []struct {
+		name        string
+		sources     []runtime.Object
+		navlinks    []runtime.Object
+		written     *uiv1.NavLink
+		wantErr     bool
+		wantNavlink []string
+	}{
+		{
+			name:        "create missing",
+			sources:     []runtime.Object{testPrometheus("team", "prometheus")},
+			navlinks:    []runtime.Object{testNavlink("team", "prometheus-operated")},
+			wantNavlink: []string{"monitoring-team-project-monitoring-grafana", "monitoring-team-prometheus-operated"},
+		},
+		{
+			name:        "prune without source",
+			navlinks:    []runtime.Object{testNavlink("gone", "prometheus-operated"), testNavlink("gone", "project-monitoring-grafana")},
+			wantNavlink: []string{},
+		},
+		{
+			name:        "prune legacy owner",
+			navlinks:    []runtime.Object{legacy, unmanaged},
+			wantNavlink: []string{"rancher-docs"},
+		},
+		{
+			name:    "namespace kept after build failure",
+			sources: []runtime.Object{invalid},
+			navlinks: []runtime.Object{
+				testNavlink("broken", "prometheus-operated"),
+				testNavlink("gone", "prometheus-operated"),
+			},
+			wantErr:     true,
+			wantNavlink: []string{"monitoring-broken-prometheus-operated"},
+		},
+		{
+			name:        "written while listing sources",
+			written:     testNavlink("late", "prometheus-operated"),
+			wantNavlink: []string{"monitoring-late-prometheus-operated"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			navlinks := NewSimpleFakeUiV1(tt.navlinks...)
+			sources := newFakeSources(tt.sources...)
+			if tt.written != nil {
+				// the webhook writes the navlinks of a Prometheus not listed yet
+				sources.monitoring.(*FakeMonitoringV1).PrependReactor("list", "prometheuses", func(k8stesting.Action) (bool, runtime.Object, error) {
+					if tt.written == nil {
+						return false, nil, nil
+					}
+					if _, err := navlinks.NavLinks().Create(context.Background(), tt.written, metav1.CreateOptions{}); err != nil {
+						t.Error(err)
+					}
+					tt.written = nil
+					return false, nil, nil
+				})
+			}
+
+			err := backfillNavlinks(context.Background(), navlinks.NavLinks(), sources, testBuilder(t, defaultConfig()))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %v, want error %t", err, tt.wantErr)
+			}
+			if names := navlinkNames(t, navlinks); !reflect.DeepEqual(names, tt.wantNavlink) {
+				t.Errorf("navlinks = %v, want %v", names, tt.wantNavlink)
+			}
+		})
+	}
+}
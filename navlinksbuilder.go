@@ -0,0 +1,209 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	uiv1 "github.com/rancher/rancher/pkg/apis/ui.cattle.io/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+// navlinkBuilder builds the navlinks of the config for source objects
+type navlinkBuilder struct {
+	config     *Config
+	naming     *naming
+	namespaces corev1client.NamespacesGetter
+	// templates are the NavLinkTemplates, none when nil
+	templates cache.Store
+	// routes look up the public URLs of direct links, proxy links only when nil
+	routes *routeClients
+	// services are looked up for the real service of monitoring objects, none when nil
+	services corev1client.ServicesGetter
+	// gate holds back navlinks to services without ready endpoint, none when nil
+	gate *serviceGate
+}
+
+// newNavlinkBuilder parses the naming templates of the config and renders them for
+// sample objects, so invalid templates fail on startup. The namespaces are read for the
+// NamespaceLabels of the templates.
+func newNavlinkBuilder(config *Config, namespaces corev1client.NamespacesGetter) (*navlinkBuilder, error) {
+	n, err := parseNaming(config.Naming)
+	if err != nil {
+		return nil, err
+	}
+	sample := metav1.ObjectMeta{
+		Namespace:   "namespace",
+		Name:        "sample",
+		Labels:      map[string]string{},
+		Annotations: map[string]string{},
+	}
+	b := &navlinkBuilder{config: config, naming: n}
+	for _, kind := range sourceKinds {
+		obj := newSource(kind)
+		sample.DeepCopyInto(metaOf(obj))
+		if svc, ok := obj.(*corev1.Service); ok {
+			// only labeled Services with a port have navlinks
+			svc.Labels[serviceEnabledLabel] = "true"
+			svc.Spec.Ports = []corev1.ServicePort{{Port: 80}}
+		}
+		if _, err := b.navlinks(context.Background(), obj); err != nil {
+			return nil, fmt.Errorf("%s: %w", kind, err)
+		}
+	}
+	b.namespaces = namespaces
+	return b, nil
+}
+
+// offline returns a copy of the builder without lookups, it validates source objects and names their
+// navlinks without discovered services, direct URLs, namespace labels and gate
+func (b *navlinkBuilder) offline() *navlinkBuilder {
+	o := *b
+	o.namespaces = nil
+	o.routes = nil
+	o.services = nil
+	o.gate = nil
+	return &o
+}
+
+// navlinks returns the navlinks of a source object, without the pending navlinks of the gate
+func (b *navlinkBuilder) navlinks(ctx context.Context, obj metav1.Object) ([]uiv1.NavLink, error) {
+	navlinks, err := b.sourceNavlinks(ctx, obj)
+	if err != nil || b.gate == nil {
+		return navlinks, err
+	}
+	return b.gate.filter(navlinks), nil
+}
+
+// sourceNavlinks returns the navlinks of a source object by kind
+func (b *navlinkBuilder) sourceNavlinks(ctx context.Context, obj metav1.Object) ([]uiv1.NavLink, error) {
+	switch source := obj.(type) {
+	case *monitoringv1.Prometheus:
+		return b.prometheusNavlinks(ctx, source)
+	case *monitoringv1.Alertmanager:
+		return b.alertmanagerNavlinks(ctx, source)
+	case *monitoringv1.ThanosRuler:
+		return b.thanosRulerNavlinks(ctx, source)
+	case *Grafana:
+		return b.grafanaNavlinks(ctx, source)
+	case *corev1.Service:
+		return b.serviceNavlinks(ctx, source)
+	}
+	return nil, fmt.Errorf("unsupported source %T", obj)
+}
+
+// sourceNavlink returns the navlink of the link for the source object, named by the naming templates
+func (b *navlinkBuilder) sourceNavlink(ctx context.Context, obj metav1.Object, apiVersion string, kind string, link LinkConfig) (uiv1.NavLink, error) {
+	nl := specNavlinks(obj.GetNamespace(), link)
+	if err := b.naming.apply(&nl, newNamingData(ctx, b.namespaces, obj, link)); err != nil {
+		return uiv1.NavLink{}, fmt.Errorf("link %s: %w", link.Name, err)
+	}
+	if b.config.direct(link) {
+		b.routes.directURL(ctx, &nl)
+	}
+	setNavlinkSource(&nl, apiVersion, kind, obj)
+	return nl, nil
+}
+
+// resourceNavlinks returns the navlink of the link for an object of the kind,
+// overridden by the annotations of the object
+func (b *navlinkBuilder) resourceNavlinks(ctx context.Context, obj metav1.Object, apiVersion string, kind string, link LinkConfig) ([]uiv1.NavLink, error) {
+	skip, err := skipNavlinks(obj.GetAnnotations())
+	if err != nil || skip {
+		return nil, err
+	}
+	link, skip, err = overrideLink(obj.GetAnnotations(), link)
+	if err != nil || skip {
+		return nil, err
+	}
+	link = b.discoverService(ctx, obj, kind, link)
+	nl, err := b.sourceNavlink(ctx, obj, apiVersion, kind, link)
+	if err != nil {
+		return nil, err
+	}
+	return []uiv1.NavLink{nl}, nil
+}
+
+// prometheusNavlinks returns the navlinks of the config and the NavLinkTemplates for a Prometheus object,
+// overridden by the annotations of the Prometheus. Links to prometheus-operated follow the
+// externalUrl, routePrefix and web TLS of the Prometheus.
+func (b *navlinkBuilder) prometheusNavlinks(ctx context.Context, prom *monitoringv1.Prometheus) ([]uiv1.NavLink, error) {
+	skip, err := skipNavlinks(prom.Annotations)
+	if err != nil || skip {
+		return nil, err
+	}
+	navlinks := make([]uiv1.NavLink, 0, len(b.config.Links))
+	seen := map[string]bool{}
+	for _, link := range b.config.Links {
+		link, skip, err := overrideLink(prom.Annotations, link)
+		if err != nil {
+			return nil, fmt.Errorf("link %s: %w", link.Name, err)
+		}
+		if skip {
+			continue
+		}
+		operated := link.Service == prometheusService
+		if operated {
+			link.Scheme = linkScheme(link.Scheme, prom.Spec.Web != nil && prom.Spec.Web.TLSConfig != nil)
+			link = b.discoverService(ctx, prom, monitoringv1.PrometheusesKind, link)
+		}
+		nl, err := b.sourceNavlink(ctx, prom, monitoringv1.SchemeGroupVersion.String(), monitoringv1.PrometheusesKind, link)
+		if err != nil {
+			return nil, err
+		}
+		if operated {
+			setWebRoute(&nl, prom.Spec.ExternalURL, prom.Spec.RoutePrefix)
+		}
+		if seen[nl.Name] {
+			return nil, fmt.Errorf("link %s: duplicate navlink name %s", link.Name, nl.Name)
+		}
+		seen[nl.Name] = true
+		navlinks = append(navlinks, nl)
+	}
+	return append(navlinks, b.templateNavlinks(ctx, prom, seen)...), nil
+}
+
+// desiredNavlinks returns the desired navlinks for the source objects, the navlinks of a
+// namespace are built from the first object of each kind by name and from each Grafana and Service. Objects being deleted
+// have no navlinks. Namespaces whose navlinks failed to build are returned with their error.
+func (b *navlinkBuilder) desiredNavlinks(ctx context.Context, sources []metav1.Object) ([]uiv1.NavLink, map[string]error) {
+	sorted := make([]metav1.Object, len(sources))
+	copy(sorted, sources)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].GetNamespace() != sorted[j].GetNamespace() {
+			return sorted[i].GetNamespace() < sorted[j].GetNamespace()
+		}
+		if sourceKind(sorted[i]) != sourceKind(sorted[j]) {
+			return sourceKind(sorted[i]) < sourceKind(sorted[j])
+		}
+		return sorted[i].GetName() < sorted[j].GetName()
+	})
+
+	var desired []uiv1.NavLink
+	failed := map[string]error{}
+	seen := map[string]bool{}
+	for _, obj := range sorted {
+		key := sourceKey(obj)
+		if obj.GetDeletionTimestamp() != nil || seen[key] {
+			continue
+		}
+		seen[key] = true
+		navlinks, err := b.navlinks(ctx, obj)
+		if err != nil {
+			failed[obj.GetNamespace()] = err
+			continue
+		}
+		desired = append(desired, navlinks...)
+	}
+	kept := desired[:0]
+	for _, nl := range desired {
+		if failed[nl.Labels[sourceNamespaceLabel]] == nil {
+			kept = append(kept, nl)
+		}
+	}
+	return kept, failed
+}
@@ -0,0 +1,209 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	uiv1 "github.com/rancher/rancher/pkg/apis/ui.cattle.io/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/workqueue"
+)
+
+var (
+	changeQueueDepth = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "navlinks_change_queue_depth",
+		Help: "Number of source object changes admitted and not resolved to navlink writes yet, including retries",
+	})
+)
+
+// changeQueue resolves the source object changes of admission requests to navlink writes in the
+// background, so the admission doesn't wait for the lookups of the builder and the navlinks. Failed
+// changes are retried with exponential backoff. The changes of a source object are merged.
+type changeQueue struct {
+	navlinks NavLinkInterface
+	builder  *navlinkBuilder
+	writes   *writeQueue
+	queue    workqueue.RateLimitingInterface
+
+	mu sync.Mutex
+	// pending is the merged change by source object
+	pending map[string]*sourceChange
+}
+
+// sourceChange is the admitted change of a source object, old is nil on create and obj on delete
+type sourceChange struct {
+	old  metav1.Object
+	obj  metav1.Object
+	user authenticationv1.UserInfo
+}
+
+// newChangeQueue returns a queue resolving changes with the builder and the navlinks of the client to
+// writes of the write queue, retried after the delays of the rate limiter
+func newChangeQueue(navlinks NavLinkInterface, builder *navlinkBuilder, writes *writeQueue, limiter workqueue.RateLimiter) *changeQueue {
+	return &changeQueue{
+		navlinks: navlinks,
+		builder:  builder,
+		writes:   writes,
+		queue:    workqueue.NewRateLimitingQueueWithConfig(limiter, workqueue.RateLimitingQueueConfig{Name: "navlink-changes"}),
+		pending:  map[string]*sourceChange{},
+	}
+}
+
+// add queues the change, merged with a pending change of the source object
+func (q *changeQueue) add(change *sourceChange) {
+	source := change.obj
+	if source == nil {
+		source = change.old
+	}
+	key := sourceKind(source) + "/" + source.GetNamespace() + "/" + source.GetName()
+	q.mu.Lock()
+	// the navlinks are still the ones before the pending change, deletes and changes after a delete don't depend on them
+	if pending, ok := q.pending[key]; ok && pending.obj != nil && change.obj != nil {
+		change.old = pending.old
+	}
+	q.pending[key] = change
+	changeQueueDepth.Set(float64(len(q.pending)))
+	q.mu.Unlock()
+	q.queue.Forget(key)
+	q.queue.Add(key)
+}
+
+// Run runs the workers until the context is done, drain before to resolve the queued changes
+func (q *changeQueue) Run(ctx context.Context, workers int) {
+	defer utilruntime.HandleCrash()
+	defer q.queue.ShutDown()
+
+	for i := 0; i < workers; i++ {
+		go wait.UntilWithContext(ctx, q.runWorker, time.Second)
+	}
+	<-ctx.Done()
+}
+
+// drain stops queueing changes and waits up to the timeout for the queued changes to be resolved,
+// it reports whether all changes are resolved
+func (q *changeQueue) drain(timeout time.Duration) bool {
+	drainQueue(q.queue, timeout)
+	q.mu.Lock()
+	pending := len(q.pending)
+	q.mu.Unlock()
+	if pending > 0 {
+		glog.Errorf("%d navlink changes not resolved on shutdown", pending)
+		return false
+	}
+	return true
+}
+
+func (q *changeQueue) runWorker(ctx context.Context) {
+	for q.processNextItem(ctx) {
+	}
+}
+
+func (q *changeQueue) processNextItem(ctx context.Context) bool {
+	key, quit := q.queue.Get()
+	if quit {
+		return false
+	}
+	defer q.queue.Done(key)
+
+	q.mu.Lock()
+	change, found := q.pending[key.(string)]
+	q.mu.Unlock()
+	if !found {
+		q.queue.Forget(key)
+		return true
+	}
+
+	err := q.resolve(ctx, change)
+	switch {
+	case err == nil:
+	case resourceUnavailable(err):
+		glog.Errorf("navlinks resource not available, dropping change of %s: %v", key, err)
+	case q.queue.NumRequeues(key) < maxWriteRetries:
+		glog.Errorf("error resolving navlinks of %s, retrying: %v", key, err)
+		q.queue.AddRateLimited(key)
+		return true
+	default:
+		glog.Errorf("error resolving navlinks of %s, giving up: %v", key, err)
+	}
+	q.mu.Lock()
+	// a change queued meanwhile is processed again
+	if q.pending[key.(string)] == change {
+		delete(q.pending, key.(string))
+	}
+	changeQueueDepth.Set(float64(len(q.pending)))
+	q.mu.Unlock()
+	q.queue.Forget(key)
+	return true
+}
+
+// resolve queues the creation, patch and deletion of the navlinks changed by the change
+func (q *changeQueue) resolve(ctx context.Context, change *sourceChange) error {
+	if change.obj == nil {
+		return q.resolveDelete(ctx, change.old)
+	}
+	var before []uiv1.NavLink
+	if change.old != nil {
+		var err error
+		if before, err = q.builder.navlinks(ctx, change.old); err != nil {
+			glog.Errorf("error building navlinks before the change: %v", err)
+		}
+	}
+	after, err := q.builder.navlinks(ctx, change.obj)
+	if err != nil {
+		return fmt.Errorf("building navlinks: %w", err)
+	}
+	for i := range after {
+		setNavlinkRequester(&after[i], change.user)
+	}
+	created, changed, deleted := diffNavlinks(before, after)
+
+	for i := range created {
+		q.writes.create(&created[i])
+	}
+	for i := range changed {
+		q.writes.patch(&changed[i])
+	}
+	for _, nl := range deleted {
+		q.writes.delete(nl.Name)
+	}
+	if len(created)+len(changed)+len(deleted) > 0 {
+		glog.Infof("navlinks queued for %s, create: %s, patch: %s, delete: %s", change.obj.GetNamespace(),
+			navlinkList(created), navlinkList(changed), navlinkList(deleted))
+	}
+	return nil
+}
+
+// resolveDelete queues the deletion of the navlinks of the kind of the deleted source object in the
+// namespace, Grafanas and Services have their own navlinks and delete only them
+func (q *changeQueue) resolveDelete(ctx context.Context, obj metav1.Object) error {
+	kind := sourceKind(obj)
+	// the names depend on the naming templates, so the navlinks are selected by label
+	current, err := q.navlinks.List(ctx, metav1.ListOptions{LabelSelector: sourceSelector(obj.GetNamespace(), kind).String()})
+	if err != nil {
+		return fmt.Errorf("listing navlinks: %w", err)
+	}
+	navlinks := current.Items
+	if perObjectKind(kind) {
+		navlinks = navlinks[:0]
+		for _, nl := range current.Items {
+			if nl.Annotations[sourceNameAnnotation] == obj.GetName() {
+				navlinks = append(navlinks, nl)
+			}
+		}
+	}
+
+	for _, nl := range navlinks {
+		q.writes.delete(nl.Name)
+	}
+	glog.Info("navlinks queued for deletion: ", navlinkList(navlinks))
+	return nil
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	v1 "k8s.io/api/admission/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/util/workqueue"
+)
+
+func TestServeWithoutLookups(t *testing.T) {
+	config := defaultConfig()
+	config.Access = accessDirect
+	config.Naming.Group = `{{ index .NamespaceLabels "team" | default .Namespace }}`
+	client := fake.NewSimpleClientset()
+	builder := testBuilder(t, config)
+	builder.namespaces = client.CoreV1()
+	builder.services = client.CoreV1()
+	builder.routes = &routeClients{ingresses: client.NetworkingV1(), gateway: NewSimpleFakeGatewayV1()}
+	navlinks := NewSimpleFakeUiV1()
+	nls := NewNavlinksServerHandler(navlinks.NavLinks(), newFakeSources(), builder, nil)
+
+	serve := func(operation v1.Operation) {
+		body := testAdmissionReview(t, operation, false, testPrometheus("team", "prometheus"))
+		nls.serve(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/validate", bytes.NewReader(body)))
+	}
+	// the admission requests don't call the API server, the workers do
+	serve(v1.Create)
+	serve(v1.Update)
+	if actions := client.Actions(); len(actions) != 0 {
+		t.Errorf("lookups = %v, want none before resolving", actions)
+	}
+	if actions := navlinks.Actions(); len(actions) != 0 {
+		t.Errorf("navlinks actions = %v, want none before resolving", actions)
+	}
+	drainWrites(t, nls)
+	if len(client.Actions()) == 0 {
+		t.Error("no lookups when resolving")
+	}
+	if names := navlinkNames(t, navlinks); len(names) != 2 {
+		t.Errorf("navlinks = %v, want both created", names)
+	}
+
+	navlinks.ClearActions()
+	serve(v1.Delete)
+	if actions := navlinks.Actions(); len(actions) != 0 {
+		t.Errorf("navlinks actions = %v, want none before resolving", actions)
+	}
+	drainWrites(t, nls)
+	if names := navlinkNames(t, navlinks); len(names) != 0 {
+		t.Errorf("navlinks = %v, want none after the delete", names)
+	}
+}
+
+func TestChangeQueueMerge(t *testing.T) {
+	prom := testPrometheus("team", "prometheus")
+	changed := testPrometheus("team", "prometheus")
+	changed.Annotations = map[string]string{overridePrefix + "grafana-skip": "true"}
+
+	tests := []struct {
+		name    string
+		changes []*sourceChange
+		want    *sourceChange
+	}{
+		{
+			name:    "update after create",
+			changes: []*sourceChange{{obj: prom}, {old: prom, obj: changed}},
+			want:    &sourceChange{obj: changed},
+		},
+		{
+			name:    "update after update",
+			changes: []*sourceChange{{old: changed, obj: prom}, {old: prom, obj: changed}},
+			want:    &sourceChange{old: changed, obj: changed},
+		},
+		{
+			name:    "delete after update",
+			changes: []*sourceChange{{old: changed, obj: prom}, {old: prom}},
+			want:    &sourceChange{old: prom},
+		},
+		{
+			name:    "create after delete",
+			changes: []*sourceChange{{old: prom}, {obj: changed}},
+			want:    &sourceChange{obj: changed},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			navlinks := NewSimpleFakeUiV1().NavLinks()
+			writes := newWriteQueue(navlinks, workqueue.NewItemExponentialFailureRateLimiter(0, 0))
+			q := newChangeQueue(navlinks, testBuilder(t, defaultConfig()), writes, workqueue.NewItemExponentialFailureRateLimiter(0, 0))
+			for _, change := range tt.changes {
+				q.add(change)
+			}
+			if got := q.queue.Len(); got != 1 {
+				t.Errorf("queue length = %d, want 1", got)
+			}
+			if got := q.pending["Prometheus/team/prometheus"]; !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("pending = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,233 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/yaml"
+)
+
+// builtin icons referenced by name in the link config
+var icons = map[string]string{
+	"prometheus":   logoPrometheus,
+	"alertmanager": logoAlertmanager,
+	"grafana":      logoGrafana,
+	"thanos":       logoThanos,
+}
+
+// Config is the configuration file of the webhook, in YAML or JSON
+type Config struct {
+	// Links are the navlinks created for each Prometheus
+	Links []LinkConfig `json:"links"`
+	// Naming are the templates of the navlink names and labels
+	Naming NamingConfig `json:"naming,omitempty"`
+	// Access is proxy for navlinks through the Rancher proxy, or direct for the public URL
+	// of an Ingress or HTTPRoute to the service when there is one, default proxy
+	Access string `json:"access,omitempty"`
+	// Alertmanager is the navlink created for each Alertmanager
+	Alertmanager ResourceConfig `json:"alertmanager,omitempty"`
+	// ThanosRuler is the navlink created for each ThanosRuler
+	ThanosRuler ResourceConfig `json:"thanosRuler,omitempty"`
+	// GrafanaOperator is the navlink created for each Grafana of the Grafana Operator
+	GrafanaOperator ResourceConfig `json:"grafanaOperator,omitempty"`
+	// Services are the navlinks of Services labeled navlinks.cattle.io/enabled=true,
+	// Icon is the default icon and Label is not used
+	Services ResourceConfig `json:"services,omitempty"`
+}
+
+// ResourceConfig defines the navlink created for the objects of a kind
+type ResourceConfig struct {
+	// Disabled creates no navlinks for the kind
+	Disabled bool `json:"disabled,omitempty"`
+	// Icon is a builtin icon, a data URI or an URL, default the icon of the kind
+	Icon string `json:"icon,omitempty"`
+	// Label is shown in the Rancher UI
+	Label string `json:"label,omitempty"`
+	// Access is proxy or direct, default the access of the config
+	Access string `json:"access,omitempty"`
+	// Scheme is http or https, default https when the web UI of the object has TLS
+	Scheme string `json:"scheme,omitempty"`
+}
+
+// NamingConfig are text/template expressions rendered for each navlink with the fields
+// Namespace, Name, Labels, Annotations of the source object, Service and Link, and the
+// method NamespaceLabels. Empty templates are the defaults.
+type NamingConfig struct {
+	// Name of the navlink, default monitoring-{{ .Namespace }}-{{ .Service }}
+	Name string `json:"name,omitempty"`
+	// Group in the Rancher UI, default monitoring-{{ .Namespace }}
+	Group string `json:"group,omitempty"`
+	// Label shown in the Rancher UI, default {{ .Link.Label }}
+	Label string `json:"label,omitempty"`
+	// SideLabel shown in the Rancher UI, default empty
+	SideLabel string `json:"sideLabel,omitempty"`
+}
+
+// LinkConfig defines a navlink to a service in the namespace of the Prometheus
+type LinkConfig struct {
+	// Name identifies the link
+	Name string `json:"name"`
+	// Service is the name of the target service
+	Service string `json:"service"`
+	// Port is the number or the name of the service port
+	Port string `json:"port"`
+	// Scheme is http or https, default https for prometheus-operated with web TLS, else http
+	Scheme string `json:"scheme,omitempty"`
+	// Path is appended to the service url
+	Path string `json:"path,omitempty"`
+	// Target is the browser target, default _blank
+	Target string `json:"target,omitempty"`
+	// Icon is a builtin icon (prometheus, alertmanager, grafana, thanos), a data URI or an URL
+	Icon string `json:"icon,omitempty"`
+	// Label is shown in the Rancher UI, default the navlink name
+	Label string `json:"label,omitempty"`
+	// Access is proxy or direct, default the access of the config
+	Access string `json:"access,omitempty"`
+}
+
+// defaultConfig creates the navlinks for Prometheus and Grafana of Rancher project monitoring,
+// Alertmanager navlinks are created for the Alertmanager objects
+func defaultConfig() *Config {
+	return &Config{
+		Links: []LinkConfig{
+			{Name: "prometheus", Service: "prometheus-operated", Port: "9090", Icon: "prometheus"},
+			{Name: "grafana", Service: "project-monitoring-grafana", Port: "80", Icon: "grafana"},
+		},
+	}
+}
+
+// loadConfig reads and validates the config file, the default config without path
+func loadConfig(path string) (*Config, error) {
+	if path == "" {
+		return defaultConfig(), nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading config %s: %w", path, err)
+	}
+	config := &Config{}
+	if err := yaml.UnmarshalStrict(data, config); err != nil {
+		return nil, fmt.Errorf("parsing config %s: %w", path, err)
+	}
+	if err := config.validate(); err != nil {
+		return nil, fmt.Errorf("invalid config %s: %w", path, err)
+	}
+	return config, nil
+}
+
+// validate checks the link definitions
+func (c *Config) validate() error {
+	names := map[string]bool{}
+	for i, link := range c.Links {
+		if errs := validation.IsDNS1123Label(link.Name); len(errs) > 0 {
+			return fmt.Errorf("links[%d]: name %q: %v", i, link.Name, errs)
+		}
+		if names[link.Name] {
+			return fmt.Errorf("links[%d]: duplicate name %q", i, link.Name)
+		}
+		names[link.Name] = true
+		if errs := validation.IsDNS1035Label(link.Service); len(errs) > 0 {
+			return fmt.Errorf("links[%d]: service %q: %v", i, link.Service, errs)
+		}
+		if err := validatePort(link.Port); err != nil {
+			return fmt.Errorf("links[%d]: %w", i, err)
+		}
+		if err := validateScheme(link.Scheme); err != nil {
+			return fmt.Errorf("links[%d]: %w", i, err)
+		}
+		if err := validateAccess(link.Access); err != nil {
+			return fmt.Errorf("links[%d]: %w", i, err)
+		}
+	}
+	if err := validateAccess(c.Access); err != nil {
+		return err
+	}
+	for _, res := range []struct {
+		name   string
+		config ResourceConfig
+	}{
+		{"alertmanager", c.Alertmanager},
+		{"thanosRuler", c.ThanosRuler},
+		{"grafanaOperator", c.GrafanaOperator},
+		{"services", c.Services},
+	} {
+		if err := validateAccess(res.config.Access); err != nil {
+			return fmt.Errorf("%s: %w", res.name, err)
+		}
+		if err := validateScheme(res.config.Scheme); err != nil {
+			return fmt.Errorf("%s: %w", res.name, err)
+		}
+	}
+	return nil
+}
+
+// validateScheme checks the scheme is empty, http or https
+func validateScheme(scheme string) error {
+	if scheme != "" && scheme != "http" && scheme != "https" {
+		return fmt.Errorf("scheme %q must be http or https", scheme)
+	}
+	return nil
+}
+
+// validateAccess checks the access is empty, proxy or direct
+func validateAccess(access string) error {
+	if access != "" && access != accessProxy && access != accessDirect {
+		return fmt.Errorf("access %q must be %s or %s", access, accessProxy, accessDirect)
+	}
+	return nil
+}
+
+// direct reports whether the navlink of the link uses the public URL of the service
+func (c *Config) direct(link LinkConfig) bool {
+	access := link.Access
+	if access == "" {
+		access = c.Access
+	}
+	return access == accessDirect
+}
+
+// validatePort checks the port is a valid number or name
+func validatePort(port string) error {
+	if n, err := strconv.Atoi(port); err == nil {
+		if errs := validation.IsValidPortNum(n); len(errs) > 0 {
+			return fmt.Errorf("port %q: %v", port, errs)
+		}
+		return nil
+	}
+	if errs := validation.IsValidPortName(port); len(errs) > 0 {
+		return fmt.Errorf("port %q: %v", port, errs)
+	}
+	return nil
+}
+
+// sourceKinds returns the kinds of objects navlinks are created for
+func (c *Config) sourceKinds() []string {
+	kinds := []string{}
+	for _, kind := range sourceKinds {
+		if kind == monitoringv1.AlertmanagersKind && c.Alertmanager.Disabled {
+			continue
+		}
+		if kind == monitoringv1.ThanosRulerKind && c.ThanosRuler.Disabled {
+			continue
+		}
+		if kind == grafanaKind && c.GrafanaOperator.Disabled {
+			continue
+		}
+		if kind == serviceKind && c.Services.Disabled {
+			continue
+		}
+		kinds = append(kinds, kind)
+	}
+	return kinds
+}
+
+// iconSrc resolves the icon of the link to a builtin icon or returns it as it is
+func (l *LinkConfig) iconSrc() string {
+	if icon, ok := icons[l.Icon]; ok {
+		return icon
+	}
+	return l.Icon
+}
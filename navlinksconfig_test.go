@@ -0,0 +1,116 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func writeConfig(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadConfig(t *testing.T) {
+	path := writeConfig(t, `
+links:
+- name: prometheus
+  service: prometheus-operated
+  port: web
+  icon: prometheus
+- name: docs
+  service: docs
+  port: "8080"
+  scheme: https
+  path: /index.html
+  target: _self
+  icon: https://example.com/docs.png
+  label: Documentation
+`)
+	config, err := loadConfig(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []LinkConfig{
+		{Name: "prometheus", Service: "prometheus-operated", Port: "web", Icon: "prometheus"},
+		{Name: "docs", Service: "docs", Port: "8080", Scheme: "https", Path: "/index.html", Target: "_self", Icon: "https://example.com/docs.png", Label: "Documentation"},
+	}
+	if !reflect.DeepEqual(config.Links, want) {
+		t.Errorf("links = %+v, want %+v", config.Links, want)
+	}
+
+	docs := specNavlinks("team", config.Links[1])
+	if docs.Spec.IconSrc != "https://example.com/docs.png" || docs.Spec.ToService.Scheme != "https" || docs.Spec.Target != "_self" {
+		t.Errorf("navlink spec = %+v", docs.Spec)
+	}
+	if prom := specNavlinks("team", config.Links[0]); prom.Spec.IconSrc != logoPrometheus {
+		t.Errorf("builtin icon not resolved: %.30s", prom.Spec.IconSrc)
+	}
+}
+
+func TestLoadConfigDefault(t *testing.T) {
+	config, err := loadConfig("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := config.validate(); err != nil {
+		t.Fatal(err)
+	}
+	if len(config.Links) != 2 {
+		t.Errorf("default links = %d, want 2", len(config.Links))
+	}
+}
+
+func TestLoadConfigInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		err     string
+	}{
+		{
+			name:    "unknown field",
+			content: "links:\n- name: a\n  service: a\n  port: \"80\"\n  colour: red\n",
+			err:     "unknown field",
+		},
+		{
+			name:    "duplicate name",
+			content: "links:\n- name: a\n  service: a\n  port: \"80\"\n- name: a\n  service: b\n  port: \"80\"\n",
+			err:     "duplicate name",
+		},
+		{
+			name:    "invalid service",
+			content: "links:\n- name: a\n  service: svc.other-namespace\n  port: \"80\"\n",
+			err:     "service",
+		},
+		{
+			name:    "invalid port",
+			content: "links:\n- name: a\n  service: a\n  port: \"70000\"\n",
+			err:     "port",
+		},
+		{
+			name:    "invalid scheme",
+			content: "links:\n- name: a\n  service: a\n  port: \"80\"\n  scheme: ftp\n",
+			err:     "scheme",
+		},
+		{
+			name:    "invalid access",
+			content: "links: []\ngrafanaOperator:\n  access: public\n",
+			err:     "grafanaOperator: access",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := loadConfig(writeConfig(t, tt.content))
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("error = %v, want containing %q", err, tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,371 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	uiv1 "github.com/rancher/rancher/pkg/apis/ui.cattle.io/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+)
+
+const (
+	controllerWorkers = 2
+	maxRequeues       = 10
+)
+
+// defaultDiscoveryBackoff retries the discovery of the source kinds from one second up to five minutes
+var defaultDiscoveryBackoff = wait.Backoff{Duration: time.Second, Factor: 2, Steps: 10, Cap: 5 * time.Minute}
+
+// NavlinksController watches the source objects and reconciles the navlinks of their namespaces
+type NavlinksController struct {
+	navlinks   NavLinkInterface
+	sources    *sourceClients
+	builder    *navlinkBuilder
+	prometheus cache.SharedIndexInformer
+	// informers of all source kinds by kind, including prometheus
+	informers map[string]cache.SharedIndexInformer
+	// gate are the informers of the builder gate, their changes reconcile namespaces with sources
+	gate      []cache.SharedIndexInformer
+	queue     workqueue.RateLimitingInterface
+	finalizer bool
+	// discovery is the backoff of retrying the discovery of the source kinds
+	discovery wait.Backoff
+}
+
+// NewNavlinksController returns a controller for the given clients. With finalizer the
+// cleanup finalizer is placed on Prometheus objects, without it is removed.
+func NewNavlinksController(navlinks NavLinkInterface, sources *sourceClients, builder *navlinkBuilder, finalizer bool) *NavlinksController {
+	c := &NavlinksController{
+		navlinks:  navlinks,
+		sources:   sources,
+		builder:   builder,
+		finalizer: finalizer,
+		discovery: defaultDiscoveryBackoff,
+		informers: map[string]cache.SharedIndexInformer{},
+		queue:     workqueue.NewRateLimitingQueueWithConfig(workqueue.DefaultControllerRateLimiter(), workqueue.RateLimitingQueueConfig{Name: "navlinks"}),
+	}
+
+	for _, kind := range builder.config.sourceKinds() {
+		informer := newSourceInformer(sources, kind)
+		informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.enqueue,
+			UpdateFunc: func(_, obj interface{}) { c.enqueue(obj) },
+			DeleteFunc: c.enqueue,
+		})
+		if kind == monitoringv1.PrometheusesKind {
+			c.prometheus = informer
+		}
+		c.informers[kind] = informer
+	}
+	if builder.gate != nil {
+		for _, informer := range builder.gate.informers() {
+			informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+				AddFunc:    c.enqueueWithSources,
+				UpdateFunc: func(_, obj interface{}) { c.enqueueWithSources(obj) },
+				DeleteFunc: c.enqueueWithSources,
+			})
+			c.gate = append(c.gate, informer)
+		}
+	}
+	return c
+}
+
+// sourceListWatches return the list and watch of the source objects of all namespaces by kind
+var sourceListWatches = map[string]func(c *sourceClients) *cache.ListWatch{
+	monitoringv1.PrometheusesKind: func(c *sourceClients) *cache.ListWatch {
+		return newListWatch(c.monitoring.Prometheuses(metav1.NamespaceAll), nil)
+	},
+	monitoringv1.AlertmanagersKind: func(c *sourceClients) *cache.ListWatch {
+		return newListWatch(c.monitoring.Alertmanagers(metav1.NamespaceAll), nil)
+	},
+	monitoringv1.ThanosRulerKind: func(c *sourceClients) *cache.ListWatch {
+		return newListWatch(c.monitoring.ThanosRulers(metav1.NamespaceAll), nil)
+	},
+	grafanaKind: func(c *sourceClients) *cache.ListWatch {
+		return newListWatch(c.grafana.Grafanas(metav1.NamespaceAll), nil)
+	},
+	// only the labeled Services, removing the label deletes them from the cache
+	serviceKind: func(c *sourceClients) *cache.ListWatch {
+		return newListWatch(c.services.Services(metav1.NamespaceAll), func(opts *metav1.ListOptions) {
+			opts.LabelSelector = serviceSelector().String()
+		})
+	},
+}
+
+// listWatcher is a typed client listing and watching objects
+type listWatcher[L runtime.Object] interface {
+	List(ctx context.Context, opts metav1.ListOptions) (L, error)
+	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+}
+
+// newListWatch returns the list and watch of the client, tweak adjusts the options when set
+func newListWatch[L runtime.Object](client listWatcher[L], tweak func(opts *metav1.ListOptions)) *cache.ListWatch {
+	return &cache.ListWatch{
+		ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+			if tweak != nil {
+				tweak(&opts)
+			}
+			return client.List(context.Background(), opts)
+		},
+		WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
+			if tweak != nil {
+				tweak(&opts)
+			}
+			return client.Watch(context.Background(), opts)
+		},
+	}
+}
+
+// newSourceInformer returns an informer of the source objects of the kind, indexed by namespace
+func newSourceInformer(sources *sourceClients, kind string) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		sourceListWatches[kind](sources),
+		newSource(kind).(runtime.Object),
+		0,
+		cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
+	)
+}
+
+// enqueue adds the namespace of the object to the workqueue
+func (c *NavlinksController) enqueue(obj interface{}) {
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+	if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+	ns, _, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+	c.queue.Add(ns)
+}
+
+// enqueueWithSources adds the namespace of the object to the workqueue if it has source objects
+func (c *NavlinksController) enqueueWithSources(obj interface{}) {
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+	if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+	ns, _, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+	for _, informer := range c.informers {
+		if objs, err := informer.GetIndexer().ByIndex(cache.NamespaceIndex, ns); err == nil && len(objs) > 0 {
+			c.queue.Add(ns)
+			return
+		}
+	}
+}
+
+// enqueueAll adds all namespaces with Prometheus objects to the workqueue
+func (c *NavlinksController) enqueueAll() {
+	for _, ns := range c.prometheus.GetIndexer().ListIndexFuncValues(cache.NamespaceIndex) {
+		c.queue.Add(ns)
+	}
+}
+
+// Run starts the informer and the workers until the context is done
+func (c *NavlinksController) Run(ctx context.Context) {
+	defer utilruntime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	glog.Info("Starting navlinks controller")
+	// informers of kinds whose CRD is not installed would never sync
+	kinds, ok := c.discoverKinds(ctx)
+	if !ok {
+		return
+	}
+	synced := make([]cache.InformerSynced, 0, len(kinds))
+	for _, kind := range kinds {
+		informer := c.informers[kind]
+		go informer.Run(ctx.Done())
+		synced = append(synced, informer.HasSynced)
+	}
+	// the gate informers are run by the gate, shared with the webhook
+	for _, informer := range c.gate {
+		synced = append(synced, informer.HasSynced)
+	}
+	if !cache.WaitForCacheSync(ctx.Done(), synced...) {
+		glog.Error("Failed to sync source informers")
+		return
+	}
+
+	for i := 0; i < controllerWorkers; i++ {
+		go wait.UntilWithContext(ctx, c.runWorker, time.Second)
+	}
+
+	<-ctx.Done()
+	glog.Info("Stopping navlinks controller")
+}
+
+// discoverKinds returns the available source kinds, retried with backoff until the context is done
+func (c *NavlinksController) discoverKinds(ctx context.Context) ([]string, bool) {
+	backoff := c.discovery
+	for {
+		kinds, err := c.sources.availableKinds(ctx, c.builder.config.sourceKinds())
+		if err == nil {
+			return kinds, true
+		}
+		delay := backoff.Step()
+		glog.Errorf("Failed to discover source kinds, retrying in %s: %v", delay, err)
+		select {
+		case <-ctx.Done():
+			return nil, false
+		case <-time.After(delay):
+		}
+	}
+}
+
+func (c *NavlinksController) runWorker(ctx context.Context) {
+	for c.processNextItem(ctx) {
+	}
+}
+
+func (c *NavlinksController) processNextItem(ctx context.Context) bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	ns := key.(string)
+	err := c.reconcile(ctx, ns)
+	if err == nil {
+		c.queue.Forget(key)
+		return true
+	}
+	if c.queue.NumRequeues(key) < maxRequeues {
+		glog.Errorf("error reconciling navlinks for %s, retrying: %v", ns, err)
+		c.queue.AddRateLimited(key)
+		return true
+	}
+	glog.Errorf("error reconciling navlinks for %s, giving up: %v", ns, err)
+	c.queue.Forget(key)
+	return true
+}
+
+// reconcile converges the navlinks of the namespace to the source objects found in it.
+// Finalizers of deleted Prometheus objects are removed once their navlinks are deleted.
+func (c *NavlinksController) reconcile(ctx context.Context, ns string) error {
+	var sources []metav1.Object
+	for _, informer := range c.informers {
+		objs, err := informer.GetIndexer().ByIndex(cache.NamespaceIndex, ns)
+		if err != nil {
+			return err
+		}
+		for _, obj := range objs {
+			sources = append(sources, obj.(metav1.Object))
+		}
+	}
+	proms := []*monitoringv1.Prometheus{}
+	for _, obj := range sources {
+		if prom, ok := obj.(*monitoringv1.Prometheus); ok {
+			proms = append(proms, prom)
+		}
+	}
+
+	if c.finalizer {
+		for _, prom := range proms {
+			if prom.DeletionTimestamp == nil && !hasFinalizer(prom) {
+				if err := addFinalizer(ctx, c.sources.monitoring, prom); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	current, err := c.navlinks.List(ctx, metav1.ListOptions{LabelSelector: managedSelector(ns).String()})
+	if err != nil {
+		return fmt.Errorf("listing navlinks: %w", err)
+	}
+	desired, failed := c.builder.desiredNavlinks(ctx, sources)
+	if err := failed[ns]; err != nil {
+		// objects of the namespace failing to build don't keep deleted Prometheus objects
+		if err := c.releaseDeleted(ctx, proms, current.Items); err != nil {
+			return err
+		}
+		return fmt.Errorf("building navlinks: %w", err)
+	}
+	if err := syncNavlinks(ctx, c.navlinks, desired, current.Items); err != nil {
+		return err
+	}
+
+	for _, prom := range proms {
+		if hasFinalizer(prom) && (prom.DeletionTimestamp != nil || !c.finalizer) {
+			if err := removeFinalizer(ctx, c.sources.monitoring, prom); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// releaseDeleted deletes the Prometheus navlinks of the namespace unless a Prometheus is left
+// and removes the finalizer of the deleted Prometheus objects, used when the desired navlinks
+// of the namespace are unknown
+func (c *NavlinksController) releaseDeleted(ctx context.Context, proms []*monitoringv1.Prometheus, current []uiv1.NavLink) error {
+	var deleted []*monitoringv1.Prometheus
+	remaining := false
+	for _, prom := range proms {
+		if prom.DeletionTimestamp == nil {
+			remaining = true
+		} else if hasFinalizer(prom) {
+			deleted = append(deleted, prom)
+		}
+	}
+	if len(deleted) == 0 {
+		return nil
+	}
+	if !remaining {
+		for _, nl := range current {
+			if nl.Labels[sourceKindLabel] != monitoringv1.PrometheusesKind {
+				continue
+			}
+			err := c.navlinks.Delete(ctx, nl.Name, metav1.DeleteOptions{})
+			if err != nil && !k8serrors.IsNotFound(err) {
+				return fmt.Errorf("deleting navlink %s: %w", nl.Name, err)
+			}
+			glog.Info("navlinks deleted: ", nl.Name)
+		}
+	}
+	for _, prom := range deleted {
+		if err := removeFinalizer(ctx, c.sources.monitoring, prom); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// managedSelector selects the navlinks managed for the namespace, all managed navlinks for an empty namespace
+func managedSelector(ns string) labels.Selector {
+	set := labels.Set{managedByLabel: managedBy}
+	if ns != "" {
+		set[sourceNamespaceLabel] = ns
+	}
+	return labels.SelectorFromSet(set)
+}
+
+// sourceSelector selects the navlinks managed for the source objects of the kind in the namespace
+func sourceSelector(ns string, kind string) labels.Selector {
+	return labels.SelectorFromSet(labels.Set{
+		managedByLabel:       managedBy,
+		sourceNamespaceLabel: ns,
+		sourceKindLabel:      kind,
+	})
+}
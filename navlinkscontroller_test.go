@@ -0,0 +1,172 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestControllerReconcile(t *testing.T) {
+	deleting := testPrometheus("team", "prometheus")
+	deleting.DeletionTimestamp = &metav1.Time{}
+	deleting.Finalizers = []string{cleanupFinalizer}
+
+	tests := []struct {
+		name        string
+		finalizer   bool
+		prometheus  *monitoringv1.Prometheus
+		navlinks    []runtime.Object
+		wantNavlink []string
+		wantFinal   []string
+	}{
+		{
+			name:       "create with finalizer",
+			finalizer:  true,
+			prometheus: testPrometheus("team", "prometheus"),
+			wantNavlink: []string{
+				"monitoring-team-project-monitoring-grafana",
+				"monitoring-team-prometheus-operated",
+			},
+			wantFinal: []string{cleanupFinalizer},
+		},
+		{
+			name:       "create without finalizer",
+			prometheus: testPrometheus("team", "prometheus"),
+			wantNavlink: []string{
+				"monitoring-team-project-monitoring-grafana",
+				"monitoring-team-prometheus-operated",
+			},
+		},
+		{
+			name:       "delete with finalizer",
+			finalizer:  true,
+			prometheus: deleting,
+			navlinks: []runtime.Object{
+				testNavlink("team", "prometheus-operated"),
+				testNavlink("team", "project-monitoring-grafana"),
+			},
+			wantNavlink: []string{},
+			wantFinal:   []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			navlinks := NewSimpleFakeUiV1(tt.navlinks...)
+			sources := newFakeSources(tt.prometheus)
+			c := NewNavlinksController(navlinks.NavLinks(), sources, testBuilder(t, defaultConfig()), tt.finalizer)
+			if err := c.prometheus.GetIndexer().Add(tt.prometheus); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := c.reconcile(context.Background(), "team"); err != nil {
+				t.Fatal(err)
+			}
+
+			names := navlinkNames(t, navlinks)
+			if !reflect.DeepEqual(names, tt.wantNavlink) {
+				t.Errorf("navlinks = %v, want %v", names, tt.wantNavlink)
+			}
+			prom, err := sources.monitoring.Prometheuses("team").Get(context.Background(), "prometheus", metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(prom.Finalizers) != len(tt.wantFinal) || (len(tt.wantFinal) > 0 && !reflect.DeepEqual(prom.Finalizers, tt.wantFinal)) {
+				t.Errorf("finalizers = %v, want %v", prom.Finalizers, tt.wantFinal)
+			}
+		})
+	}
+}
+
+func TestSourceInformers(t *testing.T) {
+	labeled := testService("web", map[string]string{serviceEnabledLabel: "true"}, nil)
+	unlabeled := testService("other", nil, nil)
+	sources := newFakeSources(testPrometheus("team", "prometheus"), testAlertmanager("team", "main"), labeled, unlabeled)
+
+	for _, kind := range sourceKinds {
+		lw, ok := sourceListWatches[kind]
+		if !ok {
+			t.Fatalf("no list watch for %s", kind)
+		}
+		list, err := lw(sources).List(metav1.ListOptions{})
+		if err != nil {
+			t.Fatalf("listing %s: %v", kind, err)
+		}
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := map[string]int{monitoringv1.PrometheusesKind: 1, monitoringv1.AlertmanagersKind: 1, serviceKind: 1}[kind]
+		if len(items) != want {
+			t.Errorf("%s listed %d objects, want %d", kind, len(items), want)
+		}
+	}
+}
+
+func TestControllerReconcileDeletedWithBuildFailure(t *testing.T) {
+	deleting := testPrometheus("team", "prometheus")
+	deleting.DeletionTimestamp = &metav1.Time{}
+	deleting.Finalizers = []string{cleanupFinalizer}
+	broken := testAlertmanager("team", "main")
+	broken.Annotations = map[string]string{skipAnnotation: "maybe"}
+
+	navlinks := NewSimpleFakeUiV1(
+		testNavlink("team", "prometheus-operated"),
+		testNavlink("team", "project-monitoring-grafana"),
+		testAlertmanagerNavlink(t, testAlertmanager("team", "main")),
+	)
+	sources := newFakeSources(deleting, broken)
+	c := NewNavlinksController(navlinks.NavLinks(), sources, testBuilder(t, defaultConfig()), true)
+	for _, obj := range []metav1.Object{deleting, broken} {
+		if err := c.informers[sourceKind(obj)].GetIndexer().Add(obj); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := c.reconcile(context.Background(), "team"); err == nil {
+		t.Fatal("reconcile succeeded, want the build error of the Alertmanager")
+	}
+
+	if names := navlinkNames(t, navlinks); !reflect.DeepEqual(names, []string{"monitoring-team-alertmanager-operated"}) {
+		t.Errorf("navlinks = %v, want the Alertmanager navlink kept", names)
+	}
+	prom, err := sources.monitoring.Prometheuses("team").Get(context.Background(), "prometheus", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(prom.Finalizers) != 0 {
+		t.Errorf("finalizers = %v, want removed", prom.Finalizers)
+	}
+}
+
+func TestControllerDiscoveryRetried(t *testing.T) {
+	sources := newFakeSources(testPrometheus("team", "prometheus"))
+	failures := 2
+	sources.monitoring.(*FakeMonitoringV1).PrependReactor("list", "prometheuses", func(k8stesting.Action) (bool, runtime.Object, error) {
+		if failures == 0 {
+			return false, nil, nil
+		}
+		failures--
+		return true, nil, k8serrors.NewInternalError(errors.New("apiserver unavailable"))
+	})
+	c := NewNavlinksController(NewSimpleFakeUiV1().NavLinks(), sources, testBuilder(t, defaultConfig()), false)
+	c.discovery = wait.Backoff{Duration: time.Millisecond, Factor: 2, Steps: 10}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	go c.Run(ctx)
+	if !cache.WaitForCacheSync(ctx.Done(), c.prometheus.HasSynced) {
+		t.Fatal("prometheus informer not synced after failed discoveries")
+	}
+}
@@ -1,40 +1,100 @@
 package main
 
 import (
+	authenticationv1 "k8s.io/api/authentication/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 
 	uiv1 "github.com/rancher/rancher/pkg/apis/ui.cattle.io/v1"
 )
 
-func specNavlinks(namespace string, service string, port string, uid string, icon string) uiv1.NavLink {
+const (
+	managedByLabel       = "app.kubernetes.io/managed-by"
+	managedBy            = "navlinkswebhook"
+	sourceNamespaceLabel = "navlinks.cattle.io/namespace"
+	sourceKindLabel      = "navlinks.cattle.io/source-kind"
+
+	sourceKindAnnotation      = "navlinks.cattle.io/source-kind"
+	sourceNamespaceAnnotation = "navlinks.cattle.io/source-namespace"
+	sourceNameAnnotation      = "navlinks.cattle.io/source-name"
+	sourceUIDAnnotation       = "navlinks.cattle.io/source-uid"
+	requestedByAnnotation     = "navlinks.cattle.io/requested-by"
+)
+
+// sourceAnnotations are the annotations identifying the source object of a navlink
+var sourceAnnotations = []string{sourceKindAnnotation, sourceNamespaceAnnotation, sourceNameAnnotation, sourceUIDAnnotation}
+
+// specNavlinks returns the navlink defined by the link config for the namespace with the default naming
+func specNavlinks(namespace string, link LinkConfig) uiv1.NavLink {
+	scheme := link.Scheme
+	if scheme == "" {
+		scheme = "http"
+	}
+	target := link.Target
+	if target == "" {
+		target = "_blank"
+	}
 	return uiv1.NavLink{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      "monitoring-" + namespace + "-" + service,
-			Namespace: namespace,
-			OwnerReferences: []metav1.OwnerReference{
-				{
-					APIVersion:         "monitoring.coreos.com/v1",
-					Kind:               "Prometheus",
-					Name:               "valinkswebhook",
-					UID:                types.UID(uid),
-					Controller:         &owner,
-					BlockOwnerDeletion: &owner,
-				},
+			Name: "monitoring-" + namespace + "-" + link.Service,
+			Labels: map[string]string{
+				managedByLabel:       managedBy,
+				sourceNamespaceLabel: namespace,
 			},
 		},
 		Spec: uiv1.NavLinkSpec{
-			Target: "_blank",
+			Label:  link.Label,
+			Target: target,
 			Group:  "monitoring-" + namespace,
 			ToService: &uiv1.NavLinkTargetService{
 				Namespace: namespace,
-				Name:      service,
-				Scheme:    "http",
-				Port:      &intstr.IntOrString{Type: intstr.String, StrVal: port},
-				Path:      "",
+				Name:      link.Service,
+				Scheme:    scheme,
+				Port:      &intstr.IntOrString{Type: intstr.String, StrVal: link.Port},
+				Path:      link.Path,
 			},
-			IconSrc: icon,
+			IconSrc: link.iconSrc(),
+		},
+	}
+}
+
+// setNavlinkSource records the identity of the source object on the navlink. Navlinks are
+// cluster-scoped, so an owner reference is only set for cluster-scoped sources.
+func setNavlinkSource(nl *uiv1.NavLink, apiVersion string, kind string, source metav1.Object) {
+	if nl.Labels == nil {
+		nl.Labels = map[string]string{}
+	}
+	nl.Labels[sourceKindLabel] = kind
+	if nl.Annotations == nil {
+		nl.Annotations = map[string]string{}
+	}
+	nl.Annotations[sourceKindAnnotation] = kind
+	nl.Annotations[sourceNamespaceAnnotation] = source.GetNamespace()
+	nl.Annotations[sourceNameAnnotation] = source.GetName()
+	nl.Annotations[sourceUIDAnnotation] = string(source.GetUID())
+
+	if source.GetNamespace() != "" || source.GetUID() == "" {
+		return
+	}
+	nl.OwnerReferences = []metav1.OwnerReference{
+		{
+			APIVersion:         apiVersion,
+			Kind:               kind,
+			Name:               source.GetName(),
+			UID:                source.GetUID(),
+			Controller:         &owner,
+			BlockOwnerDeletion: &owner,
 		},
 	}
 }
+
+// setNavlinkRequester records the user whose request created the navlink
+func setNavlinkRequester(nl *uiv1.NavLink, user authenticationv1.UserInfo) {
+	if user.Username == "" {
+		return
+	}
+	if nl.Annotations == nil {
+		nl.Annotations = map[string]string{}
+	}
+	nl.Annotations[requestedByAnnotation] = user.Username
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	"github.com/golang/glog"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// operatedService describes the pods and the governing service the Prometheus Operator
+// creates for the objects of a kind
+type operatedService struct {
+	// service is the name of the governing service
+	service string
+	// app is the app.kubernetes.io/name of the pods, the pods have the object name in
+	// the label app too
+	app string
+	// port is the container port of the web UI
+	port int32
+}
+
+// operatedServices are the services of the kinds whose navlinks are discovered
+var operatedServices = map[string]operatedService{
+	monitoringv1.PrometheusesKind:  {service: prometheusService, app: "prometheus", port: 9090},
+	monitoringv1.AlertmanagersKind: {service: "alertmanager-operated", app: "alertmanager", port: 9093},
+	monitoringv1.ThanosRulerKind:   {service: "thanos-ruler-operated", app: "thanos-ruler", port: 10902},
+}
+
+// discoverService returns the link to the Service in the namespace selecting the pods of the
+// monitoring object, preferring Services with a cluster IP and the most specific selector,
+// with its port of the web UI. Links to other services than the governing service of the
+// kind, with port override or without matching Service are returned as they are, as well as
+// on errors listing the Services.
+func (b *navlinkBuilder) discoverService(ctx context.Context, obj metav1.Object, kind string, link LinkConfig) LinkConfig {
+	operated, ok := operatedServices[kind]
+	if b.services == nil || !ok || link.Service != operated.service {
+		return link
+	}
+	if _, ok := obj.GetAnnotations()[overridePrefix+link.Name+linkPortSuffix]; ok {
+		return link
+	}
+
+	list, err := b.services.Services(obj.GetNamespace()).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		glog.Errorf("error listing services of %s/%s, linking %s: %v", obj.GetNamespace(), obj.GetName(), link.Service, err)
+		return link
+	}
+	pods := labels.Set{
+		"app.kubernetes.io/name":      operated.app,
+		"app.kubernetes.io/instance":  obj.GetName(),
+		"operator.prometheus.io/name": obj.GetName(),
+		operated.app:                  obj.GetName(),
+	}
+	candidates := []corev1.Service{}
+	for _, svc := range list.Items {
+		if len(svc.Spec.Selector) > 0 && labels.SelectorFromSet(svc.Spec.Selector).Matches(pods) {
+			candidates = append(candidates, svc)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		headlessI := candidates[i].Spec.ClusterIP == corev1.ClusterIPNone
+		headlessJ := candidates[j].Spec.ClusterIP == corev1.ClusterIPNone
+		if headlessI != headlessJ {
+			return !headlessI
+		}
+		if len(candidates[i].Spec.Selector) != len(candidates[j].Spec.Selector) {
+			return len(candidates[i].Spec.Selector) > len(candidates[j].Spec.Selector)
+		}
+		return candidates[i].Name < candidates[j].Name
+	})
+
+	portName := webPortName(obj)
+	for _, svc := range candidates {
+		if port := webPort(svc.Spec.Ports, portName, operated.port); port != "" {
+			link.Service = svc.Name
+			link.Port = port
+			return link
+		}
+	}
+	return link
+}
+
+// webPortName returns the name of the container port of the web UI, spec.portName or web
+func webPortName(obj metav1.Object) string {
+	var name string
+	switch source := obj.(type) {
+	case *monitoringv1.Prometheus:
+		name = source.Spec.PortName
+	case *monitoringv1.Alertmanager:
+		name = source.Spec.PortName
+	case *monitoringv1.ThanosRuler:
+		name = source.Spec.PortName
+	}
+	if name == "" {
+		return "web"
+	}
+	return name
+}
+
+// webPort returns the name, or the number when unnamed, of the service port with the name or
+// targeting the container port of the web UI, empty when there is none
+func webPort(ports []corev1.ServicePort, name string, number int32) string {
+	for _, match := range []func(corev1.ServicePort) bool{
+		func(p corev1.ServicePort) bool { return p.Name == name },
+		func(p corev1.ServicePort) bool { return p.TargetPort.StrVal == name },
+		func(p corev1.ServicePort) bool { return p.TargetPort.IntVal == number },
+	} {
+		for _, p := range ports {
+			if !match(p) {
+				continue
+			}
+			if p.Name != "" {
+				return p.Name
+			}
+			return strconv.Itoa(int(p.Port))
+		}
+	}
+	return ""
+}
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func testSelectorService(name string, clusterIP string, selector map[string]string, ports ...corev1.ServicePort) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "team", Name: name},
+		Spec:       corev1.ServiceSpec{ClusterIP: clusterIP, Selector: selector, Ports: ports},
+	}
+}
+
+func TestDiscoverService(t *testing.T) {
+	operated := testSelectorService("prometheus-operated", corev1.ClusterIPNone,
+		map[string]string{"app.kubernetes.io/name": "prometheus"},
+		corev1.ServicePort{Name: "web", Port: 9090, TargetPort: intstr.FromString("web")})
+	kubePrometheusStack := testSelectorService("monitoring-kube-prom-prometheus", "10.0.0.1",
+		map[string]string{"app.kubernetes.io/name": "prometheus", "operator.prometheus.io/name": "prometheus"},
+		corev1.ServicePort{Name: "http-web", Port: 9090, TargetPort: intstr.FromInt(9090)},
+		corev1.ServicePort{Name: "reloader-web", Port: 8080, TargetPort: intstr.FromString("reloader-web")})
+	other := testSelectorService("other-prometheus", "10.0.0.2",
+		map[string]string{"app.kubernetes.io/name": "prometheus", "operator.prometheus.io/name": "other"},
+		corev1.ServicePort{Name: "web", Port: 9090})
+
+	tests := []struct {
+		name        string
+		services    []runtime.Object
+		portName    string
+		annotations map[string]string
+		service     string
+		port        string
+	}{
+		{
+			name:    "no services",
+			service: "prometheus-operated",
+			port:    "9090",
+		},
+		{
+			name:     "operated",
+			services: []runtime.Object{operated, other},
+			service:  "prometheus-operated",
+			port:     "web",
+		},
+		{
+			name:     "kube-prometheus-stack",
+			services: []runtime.Object{operated, kubePrometheusStack, other},
+			service:  "monitoring-kube-prom-prometheus",
+			port:     "http-web",
+		},
+		{
+			name:     "port name",
+			services: []runtime.Object{testSelectorService("prometheus-operated", corev1.ClusterIPNone, map[string]string{"app.kubernetes.io/name": "prometheus"}, corev1.ServicePort{Name: "https", Port: 8443})},
+			portName: "https",
+			service:  "prometheus-operated",
+			port:     "https",
+		},
+		{
+			name:        "port overridden",
+			services:    []runtime.Object{kubePrometheusStack},
+			annotations: map[string]string{"navlinks.cattle.io/prometheus-port": "9091"},
+			service:     "prometheus-operated",
+			port:        "9091",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			builder := testBuilder(t, defaultConfig())
+			builder.services = fake.NewSimpleClientset(tt.services...).CoreV1()
+			prom := testPrometheus("team", "prometheus")
+			prom.Annotations = tt.annotations
+			prom.Spec.PortName = tt.portName
+
+			navlinks, err := builder.prometheusNavlinks(context.Background(), prom)
+			if err != nil {
+				t.Fatal(err)
+			}
+			svc := navlinks[0].Spec.ToService
+			if svc.Name != tt.service || svc.Port.String() != tt.port {
+				t.Errorf("service = %s:%s, want %s:%s", svc.Name, svc.Port.String(), tt.service, tt.port)
+			}
+			if grafana := navlinks[1].Spec.ToService; grafana.Name != "project-monitoring-grafana" {
+				t.Errorf("grafana service = %s, want unchanged", grafana.Name)
+			}
+		})
+	}
+}
+
+func TestDiscoverServiceAlertmanager(t *testing.T) {
+	builder := testBuilder(t, defaultConfig())
+	builder.services = fake.NewSimpleClientset(testSelectorService("alertmanager-main", "10.0.0.3",
+		map[string]string{"app.kubernetes.io/name": "alertmanager", "alertmanager": "main"},
+		corev1.ServicePort{Port: 9093, TargetPort: intstr.FromInt(9093)})).CoreV1()
+
+	navlinks, err := builder.navlinks(context.Background(), &monitoringv1.Alertmanager{ObjectMeta: metav1.ObjectMeta{Namespace: "team", Name: "main"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if svc := navlinks[0].Spec.ToService; svc.Name != "alertmanager-main" || svc.Port.String() != "9093" {
+		t.Errorf("service = %s:%s, want alertmanager-main:9093", svc.Name, svc.Port.String())
+	}
+}
+
+func TestDiscoverServiceListFailure(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	client.PrependReactor("list", "services", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, k8serrors.NewInternalError(errors.New("apiserver unavailable"))
+	})
+	builder := testBuilder(t, defaultConfig())
+	builder.services = client.CoreV1()
+
+	navlinks, err := builder.prometheusNavlinks(context.Background(), testPrometheus("team", "prometheus"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if svc := navlinks[0].Spec.ToService; svc.Name != "prometheus-operated" || svc.Port.String() != "9090" {
+		t.Errorf("service = %s:%s, want the configured prometheus-operated:9090", svc.Name, svc.Port.String())
+	}
+}
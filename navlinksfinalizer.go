@@ -0,0 +1,70 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/golang/glog"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// cleanupFinalizer keeps a Prometheus until its navlinks are deleted
+const cleanupFinalizer = "navlinks.cattle.io/cleanup"
+
+// hasFinalizer reports whether the cleanup finalizer is set on the object
+func hasFinalizer(obj metav1.Object) bool {
+	for _, f := range obj.GetFinalizers() {
+		if f == cleanupFinalizer {
+			return true
+		}
+	}
+	return false
+}
+
+// addFinalizer adds the cleanup finalizer to the Prometheus
+func addFinalizer(ctx context.Context, monitoring MonitoringV1Interface, prom *monitoringv1.Prometheus) error {
+	finalizers := append(append([]string{}, prom.Finalizers...), cleanupFinalizer)
+	if err := patchFinalizers(ctx, monitoring, prom, finalizers); err != nil {
+		return err
+	}
+	glog.Info("finalizer added: ", prom.Namespace, "/", prom.Name)
+	return nil
+}
+
+// removeFinalizer removes the cleanup finalizer from the Prometheus
+func removeFinalizer(ctx context.Context, monitoring MonitoringV1Interface, prom *monitoringv1.Prometheus) error {
+	finalizers := []string{}
+	for _, f := range prom.Finalizers {
+		if f != cleanupFinalizer {
+			finalizers = append(finalizers, f)
+		}
+	}
+	if err := patchFinalizers(ctx, monitoring, prom, finalizers); err != nil {
+		return err
+	}
+	glog.Info("finalizer removed: ", prom.Namespace, "/", prom.Name)
+	return nil
+}
+
+// patchFinalizers sets the finalizers of the Prometheus, the patch fails if the object changed in between
+func patchFinalizers(ctx context.Context, monitoring MonitoringV1Interface, prom *monitoringv1.Prometheus, finalizers []string) error {
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"finalizers":      finalizers,
+			"resourceVersion": prom.ResourceVersion,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("encoding finalizer patch for %s/%s: %w", prom.Namespace, prom.Name, err)
+	}
+	_, err = monitoring.Prometheuses(prom.Namespace).Patch(ctx, prom.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("patching finalizers of %s/%s: %w", prom.Namespace, prom.Name, err)
+	}
+	return nil
+}
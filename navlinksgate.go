@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/glog"
+
+	uiv1 "github.com/rancher/rancher/pkg/apis/ui.cattle.io/v1"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	discoveryv1client "k8s.io/client-go/kubernetes/typed/discovery/v1"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+// endpointSliceServiceIndex indexes EndpointSlices by namespace/service
+const endpointSliceServiceIndex = "service"
+
+// serviceGate holds back the navlinks to services until the service exists and has a ready
+// endpoint, navlinks to URLs are not gated. Services and EndpointSlices are read from the
+// informers of all namespaces, their changes open and close the gate.
+type serviceGate struct {
+	services       cache.SharedIndexInformer
+	endpointSlices cache.SharedIndexInformer
+}
+
+// newServiceGate returns a gate reading the Services and EndpointSlices of the clients,
+// the informers are started with Run
+func newServiceGate(services corev1client.ServicesGetter, endpointSlices discoveryv1client.EndpointSlicesGetter) *serviceGate {
+	return &serviceGate{
+		services: cache.NewSharedIndexInformer(
+			newListWatch(services.Services(metav1.NamespaceAll), nil),
+			&corev1.Service{}, 0,
+			cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
+		),
+		endpointSlices: cache.NewSharedIndexInformer(
+			newListWatch(endpointSlices.EndpointSlices(metav1.NamespaceAll), nil),
+			&discoveryv1.EndpointSlice{}, 0,
+			cache.Indexers{endpointSliceServiceIndex: endpointSliceService},
+		),
+	}
+}
+
+// endpointSliceService returns the namespace/service of the EndpointSlice
+func endpointSliceService(obj interface{}) ([]string, error) {
+	slice, ok := obj.(*discoveryv1.EndpointSlice)
+	if !ok {
+		return nil, fmt.Errorf("unexpected object %T", obj)
+	}
+	name, ok := slice.Labels[discoveryv1.LabelServiceName]
+	if !ok {
+		return nil, nil
+	}
+	return []string{slice.Namespace + "/" + name}, nil
+}
+
+// Run runs the informers until the context is done
+func (g *serviceGate) Run(ctx context.Context) {
+	go g.services.Run(ctx.Done())
+	go g.endpointSlices.Run(ctx.Done())
+}
+
+// hasSynced reports whether the informers have synced
+func (g *serviceGate) hasSynced() bool {
+	return g.services.HasSynced() && g.endpointSlices.HasSynced()
+}
+
+// filter returns the navlinks whose service is ready, the others are pending. Lookup errors and
+// informers not synced yet leave the navlinks pending.
+func (g *serviceGate) filter(navlinks []uiv1.NavLink) []uiv1.NavLink {
+	ready := navlinks[:0:0]
+	for _, nl := range navlinks {
+		svc := nl.Spec.ToService
+		if svc != nil {
+			ok, err := g.ready(svc.Namespace, svc.Name)
+			if err != nil {
+				glog.Errorf("navlink %s pending, service %s/%s: %v", nl.Name, svc.Namespace, svc.Name, err)
+				continue
+			}
+			if !ok {
+				glog.Infof("navlink %s pending, service %s/%s not ready", nl.Name, svc.Namespace, svc.Name)
+				continue
+			}
+		}
+		ready = append(ready, nl)
+	}
+	return ready
+}
+
+// ready reports whether the service exists and one of its EndpointSlices has a ready endpoint
+func (g *serviceGate) ready(namespace string, name string) (bool, error) {
+	if !g.hasSynced() {
+		return false, fmt.Errorf("services not synced yet")
+	}
+	if _, err := corev1listers.NewServiceLister(g.services.GetIndexer()).Services(namespace).Get(name); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("getting service %s: %w", name, err)
+	}
+	slices, err := g.endpointSlices.GetIndexer().ByIndex(endpointSliceServiceIndex, namespace+"/"+name)
+	if err != nil {
+		return false, fmt.Errorf("listing endpointslices of %s: %w", name, err)
+	}
+	for _, obj := range slices {
+		for _, ep := range obj.(*discoveryv1.EndpointSlice).Endpoints {
+			// a nil condition is unknown and interpreted as ready
+			if ep.Conditions.Ready == nil || *ep.Conditions.Ready {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// informers returns the informers of the Services and EndpointSlices
+func (g *serviceGate) informers() []cache.SharedIndexInformer {
+	return []cache.SharedIndexInformer{g.services, g.endpointSlices}
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+)
+
+func testEndpointSlice(namespace string, service string, ready bool) *discoveryv1.EndpointSlice {
+	return &discoveryv1.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      service + "-abcde",
+			Labels:    map[string]string{discoveryv1.LabelServiceName: service},
+		},
+		Endpoints: []discoveryv1.Endpoint{{Addresses: []string{"10.1.0.1"}, Conditions: discoveryv1.EndpointConditions{Ready: &ready}}},
+	}
+}
+
+func testGate(t *testing.T, objects ...runtime.Object) *serviceGate {
+	t.Helper()
+	client := fake.NewSimpleClientset(objects...)
+	gate := newServiceGate(client.CoreV1(), client.DiscoveryV1())
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	gate.Run(ctx)
+	if !cache.WaitForCacheSync(ctx.Done(), gate.hasSynced) {
+		t.Fatal("gate informers not synced")
+	}
+	return gate
+}
+
+func TestServiceGate(t *testing.T) {
+	prometheus := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "team", Name: "prometheus-operated"}}
+	grafana := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "team", Name: "project-monitoring-grafana"}}
+
+	tests := []struct {
+		name    string
+		objects []runtime.Object
+		want    []string
+	}{
+		{
+			name: "no services",
+			want: []string{},
+		},
+		{
+			name:    "service without endpointslice",
+			objects: []runtime.Object{prometheus},
+			want:    []string{},
+		},
+		{
+			name:    "endpoint not ready",
+			objects: []runtime.Object{prometheus, testEndpointSlice("team", "prometheus-operated", false)},
+			want:    []string{},
+		},
+		{
+			name: "ready",
+			objects: []runtime.Object{
+				prometheus, testEndpointSlice("team", "prometheus-operated", true),
+				grafana, testEndpointSlice("team", "other", true),
+			},
+			want: []string{"monitoring-team-prometheus-operated"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			builder := testBuilder(t, defaultConfig())
+			builder.gate = testGate(t, tt.objects...)
+
+			navlinks, err := builder.navlinks(context.Background(), testPrometheus("team", "prometheus"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			names := []string{}
+			for _, nl := range navlinks {
+				names = append(names, nl.Name)
+			}
+			if !reflect.DeepEqual(names, tt.want) {
+				t.Errorf("navlinks = %v, want %v", names, tt.want)
+			}
+		})
+	}
+}
+
+func TestControllerGate(t *testing.T) {
+	builder := testBuilder(t, defaultConfig())
+	builder.gate = testGate(t)
+	prom := testPrometheus("team", "prometheus")
+	navlinks := NewSimpleFakeUiV1()
+	c := NewNavlinksController(navlinks.NavLinks(), newFakeSources(prom), builder, false)
+	if err := c.prometheus.GetIndexer().Add(prom); err != nil {
+		t.Fatal(err)
+	}
+	if len(c.gate) != 2 {
+		t.Fatalf("gate informers = %d, want 2", len(c.gate))
+	}
+
+	// changes of services are reconciled in namespaces with sources only
+	c.enqueueWithSources(testEndpointSlice("other", "prometheus-operated", true))
+	c.enqueueWithSources(testEndpointSlice("team", "prometheus-operated", true))
+	if c.queue.Len() != 1 {
+		t.Fatalf("queue length = %d, want 1", c.queue.Len())
+	}
+	if ns, _ := c.queue.Get(); ns != "team" {
+		t.Errorf("queued %v, want team", ns)
+	}
+
+	if err := c.reconcile(context.Background(), "team"); err != nil {
+		t.Fatal(err)
+	}
+	if names := navlinkNames(t, navlinks); len(names) != 0 {
+		t.Errorf("navlinks = %v, want none before the services are ready", names)
+	}
+}
+
+func TestServiceGateNotSynced(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "team", Name: "prometheus-operated"}},
+		testEndpointSlice("team", "prometheus-operated", true),
+	)
+	builder := testBuilder(t, defaultConfig())
+	builder.gate = newServiceGate(client.CoreV1(), client.DiscoveryV1())
+
+	// not run, lookups fail and the navlinks are pending instead of failing the build
+	navlinks, err := builder.navlinks(context.Background(), testPrometheus("team", "prometheus"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(navlinks) != 0 {
+		t.Errorf("navlinks = %d, want all pending", len(navlinks))
+	}
+	if actions := client.Actions(); len(actions) != 0 {
+		t.Errorf("api calls = %v, want none", actions)
+	}
+}
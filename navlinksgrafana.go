@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+
+	uiv1 "github.com/rancher/rancher/pkg/apis/ui.cattle.io/v1"
+)
+
+// grafanaLink is the link to the service the Grafana Operator creates for a Grafana,
+// on the http_port and protocol of its grafana.ini
+func (c *Config) grafanaLink(g *Grafana) LinkConfig {
+	port := g.Spec.Config["server"]["http_port"]
+	if port == "" {
+		port = "3000"
+	}
+	icon := c.GrafanaOperator.Icon
+	if icon == "" {
+		icon = "grafana"
+	}
+	return LinkConfig{
+		Name:    "grafana-operator",
+		Service: g.Name + "-service",
+		Port:    port,
+		Icon:    icon,
+		Label:   c.GrafanaOperator.Label,
+		Access:  c.GrafanaOperator.Access,
+		Scheme:  linkScheme(c.GrafanaOperator.Scheme, g.Spec.Config["server"]["protocol"] == "https"),
+	}
+}
+
+// grafanaNavlinks returns the navlink of a Grafana object, to the url of an external Grafana
+// if set, overridden by the annotations of the Grafana
+func (b *navlinkBuilder) grafanaNavlinks(ctx context.Context, g *Grafana) ([]uiv1.NavLink, error) {
+	if b.config.GrafanaOperator.Disabled {
+		return nil, nil
+	}
+	navlinks, err := b.resourceNavlinks(ctx, g, GrafanaSchemeGroupVersion.String(), grafanaKind, b.config.grafanaLink(g))
+	if err != nil || len(navlinks) == 0 {
+		return nil, err
+	}
+	if g.Spec.External != nil {
+		setExternalURL(&navlinks[0], g.Spec.External.URL)
+	}
+	return navlinks, nil
+}
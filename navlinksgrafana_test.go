@@ -0,0 +1,145 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	v1 "k8s.io/api/admission/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestServeGrafana(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    GrafanaSpec
+		port    string
+		toURL   string
+		allowed bool
+	}{
+		{
+			name:    "default port",
+			port:    "3000",
+			allowed: true,
+		},
+		{
+			name:    "http_port from config",
+			spec:    GrafanaSpec{Config: map[string]map[string]string{"server": {"http_port": "8080"}}},
+			port:    "8080",
+			allowed: true,
+		},
+		{
+			name:    "external",
+			spec:    GrafanaSpec{External: &GrafanaExternal{URL: "https://grafana.example.com"}},
+			toURL:   "https://grafana.example.com",
+			allowed: true,
+		},
+		{
+			name:    "invalid external",
+			spec:    GrafanaSpec{External: &GrafanaExternal{URL: "grafana.example.com"}},
+			port:    "3000",
+			allowed: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &Grafana{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "team", Name: "grafana", UID: "grafana-uid"},
+				Spec:       tt.spec,
+			}
+			navlinks := NewSimpleFakeUiV1()
+			nls := NewNavlinksServerHandler(navlinks.NavLinks(), newFakeSources(), testBuilder(t, defaultConfig()), nil)
+
+			for _, op := range []v1.Operation{v1.Create, v1.Delete} {
+				req := httptest.NewRequest(http.MethodPost, "/validate", bytes.NewReader(testAdmissionReview(t, op, false, g)))
+				rec := httptest.NewRecorder()
+				nls.serve(rec, req)
+				drainWrites(t, nls)
+				resp := v1.AdmissionReview{}
+				if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+					t.Fatal(err)
+				}
+				if op == v1.Create && resp.Response.Allowed != tt.allowed {
+					t.Fatalf("allowed = %t, want %t: %s", resp.Response.Allowed, tt.allowed, resp.Response.Result.Message)
+				}
+				if op != v1.Create || !tt.allowed {
+					continue
+				}
+
+				nl, err := navlinks.NavLinks().Get(context.Background(), "monitoring-team-grafana-service", metav1.GetOptions{})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if nl.Labels[sourceKindLabel] != grafanaKind || nl.Spec.IconSrc != logoGrafana {
+					t.Errorf("labels = %v, icon %t", nl.Labels, nl.Spec.IconSrc == logoGrafana)
+				}
+				if tt.toURL != "" {
+					if nl.Spec.ToURL != tt.toURL || nl.Spec.ToService != nil {
+						t.Errorf("toURL = %q, toService = %+v, want %q", nl.Spec.ToURL, nl.Spec.ToService, tt.toURL)
+					}
+				} else if nl.Spec.ToService.Name != "grafana-service" || nl.Spec.ToService.Port.String() != tt.port {
+					t.Errorf("toService = %+v, want grafana-service:%s", nl.Spec.ToService, tt.port)
+				}
+			}
+
+			if names := navlinkNames(t, navlinks); !reflect.DeepEqual(names, []string{}) {
+				t.Errorf("navlinks = %v after delete", names)
+			}
+		})
+	}
+}
+
+func TestAvailableKindsWithoutGrafanaCRD(t *testing.T) {
+	sources := newFakeSources()
+	sources.grafana.(*FakeGrafanaV1beta1).PrependReactor("list", "grafanas", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, k8serrors.NewNotFound(schema.GroupResource{Group: GrafanaSchemeGroupVersion.Group, Resource: "grafanas"}, "")
+	})
+
+	kinds, err := sources.availableKinds(context.Background(), defaultConfig().sourceKinds())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{monitoringv1.PrometheusesKind, monitoringv1.AlertmanagersKind, monitoringv1.ThanosRulerKind, serviceKind}
+	if !reflect.DeepEqual(kinds, want) {
+		t.Errorf("kinds = %v, want %v", kinds, want)
+	}
+}
+
+func TestGrafanasInNamespace(t *testing.T) {
+	builder := testBuilder(t, defaultConfig())
+	grafanas := []metav1.Object{
+		&Grafana{ObjectMeta: metav1.ObjectMeta{Namespace: "team", Name: "dev", UID: "dev-uid"}},
+		&Grafana{ObjectMeta: metav1.ObjectMeta{Namespace: "team", Name: "ops", UID: "ops-uid"}},
+	}
+	desired, failed := builder.desiredNavlinks(context.Background(), grafanas)
+	if len(failed) > 0 {
+		t.Fatal(failed)
+	}
+	existing := []runtime.Object{}
+	for i := range desired {
+		existing = append(existing, &desired[i])
+	}
+	navlinks := NewSimpleFakeUiV1(existing...)
+	if names := navlinkNames(t, navlinks); !reflect.DeepEqual(names, []string{"monitoring-team-dev-service", "monitoring-team-ops-service"}) {
+		t.Fatalf("desired navlinks = %v, want one per Grafana", names)
+	}
+
+	nls := NewNavlinksServerHandler(navlinks.NavLinks(), newFakeSources(grafanas[1].(runtime.Object)), builder, nil)
+	req := httptest.NewRequest(http.MethodPost, "/validate", bytes.NewReader(testAdmissionReview(t, v1.Delete, false, grafanas[0])))
+	nls.serve(httptest.NewRecorder(), req)
+	drainWrites(t, nls)
+
+	if names := navlinkNames(t, navlinks); !reflect.DeepEqual(names, []string{"monitoring-team-ops-service"}) {
+		t.Errorf("navlinks = %v, want the navlink of the remaining Grafana", names)
+	}
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	coordinationv1 "k8s.io/client-go/kubernetes/typed/coordination/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+var (
+	isLeader = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "navlinks_leader",
+		Help: "Whether this replica is the leader running the background reconciliation",
+	})
+)
+
+// leaderStatus tracks the identity of this replica and the current leader
+type leaderStatus struct {
+	mu       sync.RWMutex
+	identity string
+	leader   string
+}
+
+func (s *leaderStatus) setLeader(leader string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.leader = leader
+}
+
+// get returns the current leader and whether it is this replica
+func (s *leaderStatus) get() (string, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.leader, s.leader != "" && s.leader == s.identity
+}
+
+// runLeaderElection runs the function while this replica holds the lease, after losing
+// the lease it campaigns again until the context is done
+func runLeaderElection(ctx context.Context, config *rest.Config, namespace string, name string, status *leaderStatus, run func(ctx context.Context)) {
+	lock := &resourcelock.LeaseLock{
+		LeaseMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+		},
+		Client:     coordinationv1.NewForConfigOrDie(config),
+		LockConfig: resourcelock.ResourceLockConfig{Identity: status.identity},
+	}
+
+	for ctx.Err() == nil {
+		leaderelection.RunOrDie(ctx, leaderelection.LeaderElectionConfig{
+			Lock:            lock,
+			LeaseDuration:   15 * time.Second,
+			RenewDeadline:   10 * time.Second,
+			RetryPeriod:     2 * time.Second,
+			ReleaseOnCancel: true,
+			Name:            name,
+			Callbacks: leaderelection.LeaderCallbacks{
+				OnStartedLeading: func(ctx context.Context) {
+					glog.Infof("%s started leading", status.identity)
+					isLeader.Set(1)
+					run(ctx)
+				},
+				OnStoppedLeading: func() {
+					glog.Infof("%s stopped leading", status.identity)
+					isLeader.Set(0)
+					status.setLeader("")
+				},
+				OnNewLeader: func(identity string) {
+					glog.Infof("new leader elected: %s", identity)
+					status.setLeader(identity)
+				},
+			},
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHealthz(t *testing.T) {
+	tests := []struct {
+		name   string
+		leader *leaderStatus
+		want   string
+	}{
+		{
+			name: "without leader election",
+			want: "ok",
+		},
+		{
+			name:   "leader unknown",
+			leader: &leaderStatus{identity: "pod-a"},
+			want:   "ok\nidentity: pod-a\nleader: unknown\nis leader: false",
+		},
+		{
+			name:   "not leader",
+			leader: &leaderStatus{identity: "pod-a", leader: "pod-b"},
+			want:   "ok\nidentity: pod-a\nleader: pod-b\nis leader: false",
+		},
+		{
+			name:   "leader",
+			leader: &leaderStatus{identity: "pod-a", leader: "pod-a"},
+			want:   "ok\nidentity: pod-a\nleader: pod-a\nis leader: true",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nls := NewNavlinksServerHandler(NewSimpleFakeUiV1().NavLinks(), newFakeSources(), testBuilder(t, defaultConfig()), tt.leader)
+
+			rec := httptest.NewRecorder()
+			nls.healthz(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+			if rec.Code != http.StatusOK {
+				t.Errorf("status = %d, want %d", rec.Code, http.StatusOK)
+			}
+			if got := rec.Body.String(); got != tt.want {
+				t.Errorf("body = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLeaderStatus(t *testing.T) {
+	status := &leaderStatus{identity: "pod-a"}
+	if leader, self := status.get(); leader != "" || self {
+		t.Errorf("get = %q, %t before an election", leader, self)
+	}
+	status.setLeader("pod-a")
+	if leader, self := status.get(); leader != "pod-a" || !self {
+		t.Errorf("get = %q, %t, want pod-a, true", leader, self)
+	}
+	status.setLeader("pod-b")
+	if leader, self := status.get(); leader != "pod-b" || self {
+		t.Errorf("get = %q, %t, want pod-b, false", leader, self)
+	}
+}
@@ -4,4 +4,5 @@ const (
 	logoPrometheus   = string("data:image/webp;base64,UklGRs4jAABXRUJQVlA4IMIjAACQYACdASrCAcIBPpFIokwlpCMiIPLowLASCWdu/k5x4/1c1Xf7d9gHMKET/j3840w8AH4b/ZHuAeQG02ZiXm/kzaqmXTS/wR+jMfA2A/queZ/hB+QG0Af4D7AJ4D+M+gD+CfyP/HflJ/R9q7wsJfyPIZa+xpngPEA6SH8R9AHn/+iX/x+mZksn7fC/s4QTYoJsUE2KCbFBNigmxQToDwfwhJ0ZHnHg/hCToyPOPB/CEnRkeceD+EJOjI848H8ISdGR5x4P4Qk6MjzjwfwhJ0ZHnHg/hCToyPOPB/CEnRkeceD+EJOjI848H8ISdGR5x4P4Qk6MjzjwfwhJPW2muG/BCCo0vJ0+meWtBpeTp9M8taCJY/2Y8osfgo/PLWg0vJ0+meWtBpeTpPxyrrvdZGAS9EJ9M8taDS8nT6Z5ayDZQoYNgSS6JZNGKIp1pto0vJ0+meWtBo54aK9ZZ/ZpJNwXjyqDbRpeTp9M8taDRyJEmQxuqm3EmDPLvPTPLWg0vJ0+meWPIobMBzb5xc+kdWMcyE+meWtBpeTp9MJXsRBTRcJCZ01J/BBLDDWg0vJ0+meWtBo554VROfINgw+bHjwZrq6j0n0zy1oNLydPpnl8Fd6wl/bZBkGWVdaGkzU0zy1oNLydPpnlrQR70um9yDYLGe5O99XZjhHnHg/hCToyPOPB+9ljhS2kciphlTSMrTrTbRpeTp9M8taDSZAf+yrnJI+JYVs50+meWtBpeTp9M8taDSbdJ/tsgmpW2jS8nT6Z5a0Gl4H59M8tZoIlIj6Z5a0Gl5On0zy1mZTqDZ01WGqUnnVbPLWg0vJuIrGkQ/r+wJIl2NaSUvJ0+meN8yZGFAh55gz6NDt4fwhJ0ZHnHg/hCToyPOPB/CEnRkeceD+EJOjI848H8ISdGR5x4P4Qk6MjzjwfwhJ0ZHnHg/hCToyPOPB/CEnRkeceD+EJOjI848H8ISdGR5x4P4Qk6MjzjwfwhJ0ZHnHg/hCToyPOPB/CEnRkeceD+EJOjI848H8ISdGR5x4P4Qk6MjAAAP7/jXwxkyJ+VY1tZFeSP749qc9kc8teJGMmRPyrGtrIryR/fHtTnsjnlrxpQIntNAWyPU4hAgAJZMeOnXzaOv9Yv+PnsrnMe0q/HKHrHcIvQnb+66iFTOf8xfKB1/a/bf7Z59iqwsBje9/0lQ2DvzTXsUZLAm+vzo9vsVCUneG9nyqjvO/Xazwb/9OqleiCPigItyCPIdKFJLT/5k3wXRT+yLIwhp/6MYki4NnG2n5vpOR/kIfwgka/PFPAf9Gv5P4P7qoRM/Ex1NlX/G1zLdo8HkGOiai/ZFffvSDk0ZJs942euajEcEz/mQsKLj3t+X87kIj32hFQPYKma5mQEP/oU/+lhR15GP948u7XNff//1cA934H3gXGQfZzRO1eg+S+8P7tgeqRVQH7hhOguDR8ANi/7/50VDPrXrCR/9XiLJY8AanxfA89QfVFNDw3P/bWbLP13HPgGMfRPPvC8lm3s8u5IPJi/+JdvhsUhLhz9oohO8WoH+UAP/WTeAG4196qpXS72rQQIJaXyKWXB4wCG5/Bb/qDCbJ29pfHT9NOaeuzm/21UvZzIn/PKnvdOgtkUh5INhB9yvgihxN2BOTBqxs9LIlv+K1WN0nvHhIbnVf4O9eUrDyz/15SIb1Hw4JsDy5sAfnHRyX1JzWS7/v6//7u+cVXAWK5Vi7lTpDzq28VrwwpSTB1YGYgOGO8mv9Ly/FylQ8P///R58TDO9v9+waEtcrIb/9XHPsnR73bWdb4Q/pc1zN92N2rDvDU3mP4Rbwnf1uYDLEBqPgbpfHxo8qI6n+7IWc9ZvJD9mFxynX/4+L/3bTKT//7Lj/mglHC2y//0Ect/q4f8QUf3K8f+P/MZb33PNX0VP4J9/zGW9/gFrZk2V8/8K36/fA0M80/5uAf5S/5y0+0xX/89uH8cgpT/9e6ypi9opP//r3WVMUBaN1YMb/VWlyjU40MMNJXTgoTYHaHW1dVZT8Xf5Hl/1Gp7m7aQyjAD/fH3z7/Ih8w4itz9Zoo+P1sK396/QVHp5Pb798/+//yr/+dhu53/i9wh/1F5ZHvwU6JIWOMz/iP/9rvjUBY4zP+Jrb3rUhY4zP63RfaMFAWOM32GdHBp+XcvLJZ/fUriy/+fnOH9/srPcXtX1jf/1+LjrfLmP+cwLz//8iv/NF/8zfvf+38Yl75+ROPXvm5z/3Fqx/3A6HTC36E4zhyN1wuD//lk/JFhx6RJI/VULoe06ML6y0TM2SD/fvP8uQ7/2LePbHdrastBcR/+Vf7izcwDwwqgrPu6BdtEuSwf9zHbPvVwn/4b49GjsQfu9LWLtROuVIGkfWFxETpWijfUIKy0o+gBMdz6o64dMj8LT93ABREf43eVq+1f/cDYJX1/5ighM1FH+cbvyd/8kBvR9zybPTf/5SR7aN+qDrvWUaiSmRu+2jfqiEfEyK5Ikc/x8PNhJ9wH8v/bN3aQDb3uI4Wql57Xi/lPGkj61vyRpCF91Imhf/amf9yXgf/9Hn/GL/4bU//+3Nva//t8Pu1u9KUHG1D+meyHIbk+tv/RmxvWKQ3SfSkH2c8t1ve7UCvZ/Ee/5gT6Izg30qwE/3VdX6emwHO9v7kv3z4MJ3xFzK2BvkZNM/tyyQK0EvSw/1nfm5HLiI8EvYcW1P//mwL/3f/b3/DqzFcGvJ+ebdzf+owjzmN3vg/m++FX97jLen//3km/0t/9sh/v+4HQ7PsqC//+IJWR/+8k3+lu0m2av6w8w4f2fs8z/98k3+draBGImGel99vdNnH6pcn2SP74g3eZ1ml/nEML99xr3fDPef5fzLPQOD8KqT+UBhONkZ3do2P//yfurIGQ3kw7Edj/mAitUSvtYk5if/+1ks9VM/7lgpmpV75/9rJZ6nUr77/wID0bvP/1+moN2jXyfv3zs/C4Of8TvztJreYbafkf/Tv87/o6UH4cMBlJZHflfu0H+tZf/Bcii5GMf/8Bsf/+DH/7XdX2Spbv/gNj//wY//eALv/843X0WfWyTjPw6jfV4DeNpl99kOuRhfvczmFD++P4jDzd2//r/+CkUsGF/+v8v+U21Z8nMr/tJ2//wxsrnv94Y7//RbzyJHwnm6xWh+MFT/ejXklR8J/HynyHUk+YCX/ornM9/0cp/bgBMeVu/a979eEtjfmZvcH0tW6/o/q56V9j9VSJUTZuKP38fM0ODTmvO4wcH+5Z/YvzqDQvCFohxwJlrmwn/2C3/Fux8///Jt83/5EHQsSiJp/9v//ZWR+GjsBcCJXvSeH8UCVOJf2kQ+/1RQvj//2TfrasfcfPVFpFdQ7MGzIaOvlTnkiGVGDdaiQ22lj/95e0/LW//fFHkf//jt/5ov/ng8PvWV7/1Hl85wl7ov7fCnaq8e3ZOOWF3/1wAEW/FSu3kqyQl3/zF52Tpei4u4kJd/89oA/qI7riF7sKHBgQtA/+z+FxSyFcx9L3T8C8lx/fFAiROBe3BHn5FL/cj/8OHcI///ev/z3//P6VX+AxEb/3ff3dtwJsfb/5HWu4359T4ktZ/+ueTHdEZCXtEAsY31eUotBF5zf8LB355+msm3/+EsGF9963b5F2Ws6uMYvvp7PwqPmRzMjMw83keiX3HZ0pyB8LhUeBvj3Y/AvU+7gH7jxwCj/f5vbch+qqzFDUtJErzGs29HP/2C12h8gW0j//uLMv7ehtEHvaXEYjH31DZYw5tkgPmHByuvBDBM2WcjvMsh9fq7//6GeIT//9Ln+MX/w4xXFxOP6JxvtC2D/+jxx9H+ZUbp6Dz/99EFf3huh6/i//vQwRr+n+4y1bk6SVlGF/+n7FMJ079++R9+vRBhf/v/+Dx0P37f/r/+2z/RH/UyhzkxxBkoQ0f//DKxrvFM6WiIaIaIaIaIaIaIaJPStd0vZfwo7nM+cmt8eHianI5r7VE5//+MX/k2/8mRT/wS/oq/9y0PtzlgacfJnWHLyzJf/CefuGEU+7ZMAEwPvpFH7RiG/If05bz//VBTv2ugfitdG3/k2/8qXEio///vY/9r62Jr27FY5/Lr/aAgrNfBLj8bGqS7hs52PtPYYV6YARt3YmKR8aP22QCvjpAVmne7Rpo61/iZZd3T+bUaZNANRw2Wv6kVd7X/8/C/ebf/PK//5v/tfN/y0fpH/mDYn/KuXFRukf8qj8FGF20IQgLf/nC8nNFPttzHB+HAeucmVsDfFukge8VI8Zl7HKM58PmsHf/5n/Nx/8zdhdxu/1HsvSp8LfIph+CEv/f4a1Lf7P/3E/X9/8mei9nJXM2uoNODg/D/g8ppekwb6v+IEAcYKyBJTEDxX1/yBn7/7//Kv/5SzvYt82xlV8jl3ILjH/1GMxkvX8v/6t7rn3rOd5Mu8udm29xQtED7YeXUwfwWYMQaS5+vThn//0uf5uP/mwynBvNHg+teh9Tb6w29/9ztpM6PGgm/zvHmf9BZzNeLVa7FDHKQcHPVfaaAh3zVR+qpllHedz0YX3/mNnfduB/86DunvqLWDzjzD/9rBm3/Swdl/oZEt7lvS3v+RR4n3/g8WyQ//PoACmFrm5Q4MbjLrD0iBtBuH/93GqO6h+nT3wX+3q124YC+z9Pu1VDYIxQqv3hj/oNa2dd5HktvA/BI1y/9/bNjv+DX/wT7oWQ//plP3/f1j7dhHjPXSuS37gZn4NUlQxvT8QytAYvt2N0QML7L6WOag8FDVWjfUIVv/A0lZ/l+nWQ6H0PBUxfWRj/xr9ZF/0P+si/57vKvcJPW/375E9Ex9/4PEK+T/8+gA81aFH6n8reef6f0yf+on8eTphNFSZ2+Nv/+GJM+XvH//yExmN9Ug/f+A6IJ7lwvz9++oBFgkP9oGf9v3Rf1SCN/xi/+F5VQEsvK/roNNbf4q5Sy0i8KtAiW1GnibRegcn/Xsc/7m/tAGE1yVilv1eOBxkbzlBOvT+5tvjXKifnt1tVLuBb/+YxlG/02cDXyictK3v3RWuqPOQbi7+beeKP8wzf/UtOqkHL6oP/Ugxr27c78rmWu49TVzSJy/ECUP+25274Svpc2s1mttynYMvHwLN/e0H/7uvC3eORPE78yG3haPxx/8+c24prKuJHm/LUK4AL53IX1+j/LMsSvMNd9Ng+r2911loHvI+V4Cq6Zjv1xU///6BQFY/y1JTedhQhYPM7/64ACJ1AqyVdja9/J/Iwb/mIDbUR/2VaE0N/1isQScujvK4P94VKb18ip+3+BPxz/8+U+khd4P86FVB0//g73Qxx/8xn/xEEh8rfHRhI3Dz+vFhq6Kw5QoNalN6T2R7fxmR7iND3/9e+C3bk6U+5MZ0FpyTaodSsl/y0QhfbZR/a2JBo/bP/S1eZ0WDfUvbKVf+mY0//xO0/+P8lUdPGvh6qIjEtxG7yxR3jj/37eLgh+o1LdKenOK+9/9fz0f/ooRsMkclute/8AynhFv799dn3MpHJJrndHP5vv6JxvtHc4+VEehUA/1Q/9C5h3gH6rnpFDtA8v/Pt+vvr1Wubsrn9xtSfvhjpa/5r04smznDiv6Y/VT7TuzOmBQ/37z/K/MsII331vZkvv9j/rMM2mx/++fMn8fD7/ToJyj/9lLx39SQBqMswbLZAIjcZAJGkLgqG3Ik3//6pnsQFd//p2sinStRrPFQJJH22Y13r04jpQxq/CgPGr8KA8avva41+YSP3Ib6zFZrz/zLOr+VhjyMDdr4pJ8qLAQB9rnA7dp5xAALxBv+1bH/ZgMT/NEdk92KLosnL/hW/X74EuS4f+6N/a6LqtbvAnRkGLzPYoCe/NX3/p7nk7Zb19WJ0c76fBiOcG/5b/8te8NCTt9VexcG/4S/w/HJ2ux/ztUM9p4NI0Y/NfTW51xzwgPm0dhNocFNrNIRmqj1LBq3/NF/8zxH+S///9gX/1+3/jf4U+/WWP/kei9s1Bf9mDvjalr3nG9VGeJrDJ9dCWnee3/4YgkZFo/vjVGGFUd+m0k7B0mD/eZ/2mn3U+f+cxzz//7r/udN+qPVS6X+JLoiQ5oJO7H+1M3tY//OC6hU342v7Uze1j7AW59nAbwn7Uze0R/+uC6UOkZ5L9DowvrLTVKSCwnYiS//xivbSgDsEj9/0p6nB/v3b9lwMNouNix/7PHb/GL/4tZHFn0RA87nu+v++lDP9h4iEV066weSO4yX//COBP/6qP/4RwJ//XN9xgLzV+6B0ozVv/u9EMXDP3x964l2k/j5TM+V9TB/+3/4N9aGAsoZcYOKHaoYaG8wzHNQe8QG4KbE65obyEOH/eg96Fp73sRYWv8xh/3ACiP//y5P+O3/x6y//s0CBrMvaIEHOiN4r2T3f1KFch/7J7rDQ2pMt9YYruvaJL3/5h7VV/Gk2aH91NEb6UpBM48f1E/2//zvKG95IKF03KLfvHhCqcrSx63z/6jE9J1H9/+E3cnlyYGP+6zbf29nUjHCnnSwGOf9Rpx6hpVzfwzQHbfqsjMXU4u7V+exXZR/R5L8cnKyQX66+Al2OYzO7kK/6Q26RfmVyfmmPJ2uQh+TDB/ciH1WB7KBbteH96Mo/9/v0b8vfYxGJ28vXC6xXHa0q476D/b/4RwJ//X2WQXgjk0TU4SQE1OEkBNTf5XlQE9EfBzNbpqfbmlXj7lccUn7NqECgucc0PnrkXnZzxhYrNOwPDZ/3J5J82QUHmBIeNX8iH8av3vl7t6/2nm6bPPVA6U/xsfXrIJ5rc+2fw9WD+nGtRmVKBu+yff3/9HrT+VJpw5uOE8z+6DJnaH/4W4KXWZFAygyXrDqsM5c2jAuxrbd4kAc/DjhNTousPSTg/AAYs+ldQ7ZT3Obbv+CMff4Kvh03Uz/qCX9igO4vB5X/5+xJnv/59Vgse/g5/0ubPRi//4a/+a/cb+AltHj/soimIck+nWyp/yU1+3el8ad/qMwKc40v5A/926jvRnynVjEL8IjUzkkRGJ+4APM8fkekusJ/3LwFvrXIlObPeT+sdECiceD/iuLEIPmfiL2zp9F6oOCxT5f/eyzGqf+xWnw/j7Ju30j7yufFQF6ZZZwxol14KlOqq0ihAzXal5SyD7UpWYf393h4/Pt/FGpr2H0fPO30iugKCH/0G+T48zfqQNl8EQGxDQ+7/EvJBY/WBpgey3i8GCKr/roP4av6v2+f+thdwX+45FfeUfbs5oZz4L8oze/on/j3gV77dV3xdfKM3v5xf/Jqh/y8NGeA/RTTG8xavrp0L95+Zl4wsXb+ERHIPd/Ua2W7RdcNJzoy8k4sQd/+OviB9AaYTjz9UXRuHK/8uFV3LfrLF/9jSz+sVrd4E+v2S+Qdi+DWrAq/v/caWf1j9+v59j/hn7YU+kQWub7/63Rf0HJegMK970RPr1pZw62Hef/WMEDqKtR4PaHNX4/v9cKciJHWt7rtdGJhV++LwUaeXV/iSodsP69A3/TaKMDfP2fbHrA8bAsn90WyltP/5hrS/Iwz9y9b/tAVb/tf/+OBbtEgKp//u/P+q+CzeTrW1Zn/P2PXPkM1PaM3sJ/33RYmh6Ef2n4I/s0V5NSQHjC+OmzgaU2L/r967r8F9Ndwf3/s4j8PNJmgAAAUK5A2R748UVr8EdpYAsx8KoDCqyRuWHqwsQJbuwfkAuWQW+2zFVwc9bLff8H+A67/SFxirNz//1QuzkDdVE6rl8cm5a7u6zDXLvHwV/WgrmMsJerbsbhnXFnjIr1K3b8xb1KfDx+bqhnv3wbb9PORB/2A//r5tX//60qf+9MSqyBDqiXBJ3Pjrf/mmnHR/zEmRQzgHPxivX9a2/Ixev8ztFwde9ZYh7Fn/y2mX+auIw+/THD3+J1OcVSev+9Z5dXDGPbwoTnfLFMG0cNXHFFOE7LHuXzvy361xCPH9+4r6Vr8+H/1W+L+fq7wRYB/FIVfy3Jp08YkYHH/ZUE15ACSSnwX3/UlABP0F3ZeWbe5f60eoe0k/ktl3Rf//1Xxir7H7/7ndGmL+6vYiBf/sqxr9mxynrm95u/3hOwO06poHk0nSBsibhfM7uhn63dhPj1mTh/nczi679D/7x/obzQaySj5iQs9Gf69TKRsUmdun/cXqY18FLZ2JKXFiBWeroNYXd5zZ0BELNAPib6QK07s2G5xv/E3/ueL6L35/NvodsP65T7gu3Gokov+1m/PpIonmSOeUURUbt4Bz5gj9RkIwRsoXblyInbrsbyG30DZMuLuqn75WPs3ha8GaBALAaeBTNprj0Lle4v1/DukkO3+AgX/BL6Nxq/cDVP491QI84OhhGMFUoNHP5bSg0DreeDOJhncG/vXcVGYzHYSxErIuQ0Q0Q0Q0Ega5S1i1egRyiQwOcMP5D5w863jtxJvJvHGr8R2ONX4jscavwXf01Jd6+qur+96mwtmcacIEmY3ygxZVau7kl4f8Khb3/kGsGa7SIfe4dQ9xSWf/hwp47of9mN/2RT/NmA0zpVHnZd6+w3n5hS0t1b3rOZwSfvWcziF+9ZzOHYodzvSJRp/xMfSph+eEPPy/+IQ/9xYQcKvv/OSWhmz6zokk+AtQ/DL86mff/F/TR++DLLu6gk/8trwZF//saUz/8wef9Y1//9Hnr3rqkdGdmj+dE1//pL7W+X/0HI+V3CUHTLa1wQ0F/6wlv/BP/3mUvf4K74r/e/9dcmfL+rf/8MisWr3+xaxUK8f9W5EzNcP3vg4Ug/86iVHQH/aAGmsyLxYNJGTVR2QRFyHHKv735EzM+5yEFOz92jH74TG3qivy/r7gpUefb/9lr/1v+4lINDKlSybL3+5LpTfXQkVZQq0dWRDD436zogH+fcnw49qdKe/smu///3Yf//6O/n//6jMcZ1rQkVmkUtT1P6tyJZOsF1v/7InHT/vrkBh32X+tlTIQwetDulXD7T//5zHwfPvcrP//4AT1gKp3dHgJ5/XgSr+985A/4x5ffqfXa3v9/HAh/ehzynk6isrr74JZ+sj+/23TKwv//q/mUz/+p9ddT/3L/38b/q3ISCf74kngNPU5bT+fgomWCfBrPqmQc+sTnnr+4sqQpvJGp/VuRLJ2X/MaR+bA2Ppo4HCKv5JXrV/uORM7B1//73UvPbSL/tZI2f+hv7j/vfkffkCkaZP6+M7+0JADiXF85BucAXimpvUym4mf//zRf/KxP/gbUYDxL9/65vbPtB8tSHXX/JW+HrTMt/j8uIyT4cEBmuex837tfyKoZzbETgGPr6cz9fZPInxFo9pH3NlpuPubLTcfc2X/svR6HZHG0zX4Tz80fvvqhiGgzRsPiqxmpsHkv/0j86W01/VuRM9Ao/1bkJBRPD2+Dv0UXS9Cor/cSV3TmSmJQnnSUe8/BRNqLcQMTt1ioTCui6/5jSP3AMhS/AQL//g88G542Uv/8BD//YE1//rd5Hl9TZP9W5EsnS3978j78ktU/LO1Ww9kw/7jkTLInHG5FiV5/F+0NQs1dEpjATlQtwKO7kQ8POyPleAMHk0Xvlp7t6W8cdAL/bOOTsZ//hHAv/9c21vu/ns6FMgGR8ruDb+2ccnYerOf7TAf/3v//dnf/6xX/+wKy/+vVkTYprPSL/4RwH/fHV/N1OJEvtikPcArAxb82k+qmnrlz/69pV/e+chgnrZCCnS0DH6ZPFzNMDo9/ccbIuHvmtdpwT/YDtXzwmZ44p/vvv56bn/7qCc/gH8lvey7//WDzlbOhJkP2I3kLL/eHhQFD1ncbKv2fy95umOP8ziS/S43Rr/K6n3kRw3y9pL3SvWkxC2D4EqpZ5Yjc73Ut/ov8uT/zTm4ydHPjxzxY3ld+/ktJqTf/td7cj/SwawWB49P2vOgf/4EQJ/5mpJ6t/+BECf+ZqSerf/gRAn/jnP7pwnKQ35CtOuJSzCHz/wjP/mePP/pz/9LINaow/M1f3OPUY2oum8Nv4Zb9oB/9Hjj6P8lP/vJt7H/2N3X0mjY9HP/9XP+toX/N//kP//9HvP+LfuWh9/87iL+MLQLbuinsH/kmE8pyaf/fOFsoY279s45Oxn/+EcB/3x1fyK4+V11v/voYI1+0wfoW/+Veg2vRe0Tn8fm/tXwKZ//rRGxMf0/f2pnM7RH/zRGxMf0/f2pnM7RH/zRGxMf0/f2pnM7RH/zRGxMf05D/5Q3fv//Ydf9PA/mfoPs9T2fvv5vPuv7zQe5SqmWa/tnHM1Rn7ZxzNXz9s45msx4ozrZ/rclxL4l5h/Tf/NOn4T1E/i2f3/8rnfX+P0X2X/3ykrpP4/RfZf/fKSuk/j9F9l/98pK5XY3/kP/+7D/+K/EEVXhRpm7a3elJ9QL7s+/ic/sq9idu/8iuPld3O/+SE3f3Jp/kmH/nAQyrDnAQ0f/Jgdz/6pncQ57Ot81cav4l7xq/lv+icb6csj/+84WyhnKj6H/9+DwL/4Nf4aZn3/9SriP8lSMeoiyeff/emTbwkxQv1zPDQpTnyOzo8ryLEv+GBJaN8Vwb+92toNfIVgmLEkmrBMWKDusExYuKlYJixfypsd/7e/1vviKDj/ewnH+JUORzGwMTv/BDvVT/nf/BDvVT/nf/BDvVcZ6ffP/w1/+AY/WL/5p1f6yml/x+jJuBf4/Rk3Av8foybkf8EDkB7s//19h7jadDb/x//9IOP3w79JHy/VKl2qX5nYlmqaf/vlG/Z/Q3l9oV+f/0ocb+sdMDC6r/7yjfs/x+i+y/+pD/t7L1F9T/75Rv2ft6TfaEv/3ml3/9648NXkf/qkuqd/7S3e7DZgXi/Z3+763vphiFIm1hoUazQ98LRXnbUeY9hA//S5+Ju2ZqKL5uqTjs/Nf8XP95XYv9LkYCYDZs+v/QWczrClRmSz+B/nMf+YX8tfu+qy5/5YYbz4NY/KRnP/+X6IGz3CqZ4Tdz2/VwtCSojT+lOEj/MMfrcniz+TsXdKf//nuLetNNxS36hqnKxtdErOAVWUBbf+lzi99n61eulLd7H9YwHlXJH5fj//44J2hjT6xUwdRgY///DX/w1/+GTEOv/wDSl/wr/Av5sPWlKfKM5/LHZFX9Gf/zP9/bphFt+biZ/h0Q0sbH9/yzYH+S2ndes/3vvX/8CIF//iPqeDm2Dy//3L9zD/+6j/7R/zdP+/j1BmAnoJ6O8luwDMF/99ztT97f4v2ZgLtEUdb/9amT5j/5AUUdufzHkImwmX5wEViD+IKM6fY2AJplDNo8Tn9J5PvfniP2ca97mfcrdV+UU0VQgcq/+HTWH3h+/lltI6LvVc1GBj//8NftP1AFE/9YW4Qf5tmm6P/9cHzWXl8mohf/7vSb+mryYk7Q//+///f/L1UT/3aga/51CMn7VcIt+/4fjn+OuEf+2wQf/vP//7sNzm9v/UYRAQN+tf9It8rB977+t0XmX/3ekNuGBgYrb94c+/hmSiGJ/9KY8owPqW+UvP/FkW7UiEV9KF+NzXKPow5/qGrJayr/xnJoFSTJTw/e+QidZ7H4VnxDkOrQGBC+9MefT55r/jO79TXnS1Czp39D/5ikzaMfP73yJ17168Ai++R//8qwT/919bHDJI3/+1EK3/H6L1W12W/yymx1vmOh9ngyMmV6mmfCGTXgez5qvE/vfITsEVZugV61Al6W3RNHwSS5wYmP8Tn+NEjc0xGQHBB/1Cs8l3iQoP/63e2lqj05/W6MQi//bzTFvZ3H5/PNlubH2wYf/Y/V4vfseyvf9boxNsW6tHHfo9GhX7rp//ddev5fpLl6b/9XFL1cze30Dw/es4m3P/y1t/wO/1FdbjYKEVYqySL9oAhll9ptdjuOXf/Sb//8YvoErA/9Mp//gCdv//RbQtCbJUGk+p4CLm0F/+rYwBP/yAoBt8Xjkcdo52/LtVh/vfIROvZfvfInXvXrxILgs4pD//7AP8YviBPHfnRjJ0ZgHNx/lSixkN3Z/7GU8t/y0f+/b8++gMcXr93t6vtDXiqSpjz//4xfJZBW/nsSn+vX5Q+HL/1wpT9KcBoOJn9nxfqtv9/ebkHGoZ4oJ3tfJiiGz6H+5aRhykdP5jgs7fz84CLgd1Hiu7v/+TNqMs/+C8Tlk0TTMTvZH/Ce900X4EfQQ3+dJkYLun9/7ZJPx/wRWk5NtO8Ai//gRAf9+OnxlTGOh7g/f4t9f8zn5mas/+Dr7/76K/nY4LvL/5fyESvAUxLK/m8/vf+vwdM/NP5Hf6hscZzDO/Eso/vfIpIsScmMgAAA")
 	logoAlertmanager = string("data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAAMgAAADICAYAAACtWK6eAAAqQ0lEQVR42u2de3Bd1XX/7XGZTDOZ6QDDX0wm/BEapIstP6V7LduyLWxsyKOTlJZp0kx+AdqQBIMJkPygcX8kpeQHMWn7a9pAGgjJzzYmAfMw8kvS1dOSLVmWbMmSLCTLeoEt45gyw0zA9u767LvP1fW1ZJ1z7kP3nHv+WGNZOmefs9da37P3Wns9ZimlZgWUPqoKF8yJhkPX1kQKPyv/ltREQl8Rul/oSaHnhXYKNQp1CQ0LvSf0odBHQhcMfWR+9565psvcs9OM8aQZ8yuxZ+hnXcuzAxmklwImuKCOF5+fFV0ami0KelVNuOAz8u8XhR4T2iLUIjQo9L7QRSGVYbponjVonr3FvMsXzbtdxbvyzoHsAoBkjPaECwQQhddFI6GIKN0DQtuE+oX+aL76Ksfognm3fvOuD8TevfA65hLINABISlS9bO7sqrB8fSOFC0S5HhbaJTQkdD4HwWCXzps5MJeHmRtzZK6BzAOATEt160pn1UQKrhblWS30jFC30MceBsR09LGZ4zOxORdcDQ8CXQgAEqfKFYvEsL7pE6Ig5ULPmS3JeR+D4kqrS7/hQTk8gTcBQPJ04jVLCufIFiMkyrBJqDdPQXElsMCTTZpHwqsAIHlAe1cXz6oOF35SDNU7zD78gwAM09IHMV4V3gHv4GEAEJ9R7cqSWTXhwutF0BuFenLU65TrdMHwbiO8hKcBQDxO0aXzZkfDoRtEqJuFxgIlTxvBy83wFh4HAPHairF84SzZO99ogHFuJpWpbunNqrF0rmpaNk8dXFGkDpUtUO2rFqqjqxeprvLFqvuWJapnTbHqTSJ+x9+4hmu5h3sZg7EYk7FnGCjnNFCE1/A8AEiue6SWF82SvfL1xm15JpvKUivKun/ZXNW2coHqFKU+Lgp+Yl1EDd22VI3cVqrGbi9V73x+WVqIsRiTsXkGz+KZPJt3qM0+cM7EeF54fXXpvAAgObeVevTRWdGS0DXRSOghEdSpjINBiC94a9l8rZhv31qihkVZx9IEgJTAI8S78E68G+/Iu9ZmByinkIGWhcgkAMgMU9/27RzsXSWCudME9F3M5FapZcV8dUy2PXy1R9K4ImSaeFfemXdnDhneml00srgT2SCjACAz4bJdXDBb9r5FJsI1I2cYDaWxLdPxtcWypVnqGUBMCxiZC3Nibswxg2cpO5ERsgoAksXtVHU49Clh/uNCZzOxUqA4fWtL9D7fL6CYGiyleq7MOUMrCzJ6HJl5cdvlrdPvBZ9j1Sg1Yd0X02lTHFhepLcgwz5aKZwSc4cH8KI2/duuFi07kWEAkDRT8w8emVUdKWTVeELov9O5WhyWL+fAunBavUxeJ3gBTw6nf1VBdk8gS2QaACQdZxrz5okhHsLWqE/XqsG++8jqRWpofSQAxDQEj+BVGm2Vi0aWRcg2AEgqORnhm+dEI6G7hZmn0wUMXJ/5vI1KZfsF79IIlNPIFhkHAHFjjEdC19SEQ8+mw0OFUDmNHgmAkRYP2NH0rSjnkTGyDgBit+hBWTFhIgXCvOZUt1ScKHesWqhPnAPlTvPWS3gKb9Nwao+Mm5E5sg8AcgXaHZlLcOF6YdhIql6plrL5anBdYGNkmuAxvE6D12sE2aMDAUAmszciN82R5XZDqjkahFVwCBZ4pbLr9YLnjalvuz5AB9CFACCX2ht/Ksx5KpX8b2s7lQ+He7l86JiGbRc68BQ6kfcA6a7YSYbfn5laTq7tDcK/+28tCZQ0RwhZIJMU7ZIt6AY6krcAiYYLrhNGvOUWHOx724NVI2dXE2RTmxpI3kJH8g4gBx68n5WDvI2GVFy32tYIlDF3bRMhZJSiS7gBXUFn8gYg0XDhp40b1xXTyKo7GZyCe4aQFTJLASTN6IzvAcJXwICj1S2zMAJHAw+V5wiZIbsUQNKK7mR7JcluNG6k4Hq3KwdBc+RoB8rmbUKGKQRANqNDvgMInghjkDe4tTf6bw0HCuYbL1c4FbukAV3KlncrOzZHiXblVrh14Q760N7gcA1i6zFiijBcQuZvo7eX+vLQE5mm4AquQKd8AZDq2CHgVjeuXAw7v0XejhlAEMs0uH6pGhBFeXtdjPrWhTXxc78Qf+MaXRnFh0BBti6Nd3Rpa3UWDhMza3OEP/cnMpGn3YCD4gJ+O9+wwDEoitG/PgaI7rUlqkuoc02xOmKIn/kdfzsu13DtoE9BgoyRtUuQPI2OeRIgBJ3VhAs3uAkfoUCa3zxVFjhOGnAcl334MQEAgGi/pVi1lS9RrYb4md/xN67hWu456VOQIGtk7iosJRzakMkAx8xsq8qXkAW43k3goS/BYUrvaHDI1qlHFP6oKP9h8r9XL1b7Vy1SjZ9fpfbf9VVN/Nwkv+NvXMO13MO9cZD40A3sEiTo2Hp0zjMAqQkXkM8xGoBjQviJ4GBlYKUAGE33/K06vrtCjQ0MqFOjY5r4md813fN1fQ3XHkkACdstv/LJJUhG0TlPACQaKbzGzVlHq+xD/XoAOCz7bAzuXr1ylKhDBhyH/u8T6t3hYXXm3PuTEn/jGgsk3Mt2i7GGfRp/hg60urNJSLq6JqcBEo3cPEde9FmnRjmeDL8GHI4ZjxUGOYY3Wya9ffr+g+rU2NiU4LCIaw7KtdzDvdgkjMWK5NecF3TBhXcLnXsWHcxJgOjqI2FdYOG803MOPxdRQIlPrF8a31q1iF1Rd0tYDR5qnRYcFg0eOqTv4V5rq8WYfk4KQydcnJOge/eks1pK2upWmdI8p52ekA/6POiQLcOAKDNf/nZZAZqxO+77OzX+3lnbAOHapg1/r+/Fu8VYjOn3mDR0w8WJOzpYlK66W2k6DNRF3eqdxlblQ/gISoyLtlPsB+yI+pWi5C/8yjY4LGr/9fP6XuwXxmLMfAjaREdcxG7Vo5M5AZCahX8+21Q8dGR35EvgIUrMyXiHfPlx29aULVAdL7/kGCAdL2/X9x6UMRiLMfMlqhldcWGPPIFuzihAKEYsdscyp+cdhD3nTWYdBQ0o43lLzHNVtWKBancBkHYBCPdirLfJWIw5kkdh/y5C5SkAsSzVgtmpJj59yhSSduSxypcv35g5/8Bm0MZ52UK1Z/l81eYCIG0CEO6tX7lQryKM6WdP1mQrsQvPVgs6OiMAqVp20+xorAXBRSdGud8zARMjdE9q925EG9YNotiVouBvLp2nWrY7B0iLgIp7WUUasGNkFbHcvSM+jvpNzkx0aLRfREfR1awCpHfHjlmmec1ZJwUWyE/2OzA4wENpccNiJ3D2wRe/WhR717Ii9WpkrjroAiDcw72MwViMydg8g2fxTJ7td6CgQw4LQZxFV9HZrAGkKqzbnr3pZLmjwsWYj4ExlACK3oRAxJbVS7RxXVFapHaIgm8rCakmFwDhHu5lDMZiTMa2Ahp5pgWWIROK4kegoEPtzu2RN9HZrABEG+aRwjudHAhy4OPX0HULGFbouhWE2Fq+WBvUGhzy1X8FcCxfpF768u2qbfcu5zbI7t363pdkDMZiTMZu0mEoi/U2jmd3m5P2GFBKfRsi7/AQ8Tw668Zgd2GYh64xTRptVzz0W1G35BWjx8RYWdG52Ai1YpBXrpiv9nyhXEX/zz+ow2+8rk50HlWnz7ynzvzhnGOAcA/3njh6VB1+/TU9JmPzDJ61/5Lo3xL9Tskrit+K0zms4NiF7mYUIPTANq2WL+ajS9fqT85+3wJGlzkhP6iBsVDbB3vKFqnot+5SnW+8pkb6B9T42T84B8R0p+sy5kh/v+oELN/6ptorz6zWBnzMy8U7dSVsvU5moF+7x1y/GOwPOe3j7jCMXRd7O+WkkLRftlZjxgCPbacicRuD5CZrK7VPlLTu+99TvbU16vT4mbSDYiriWcflmXXff1DeYWF869VWPmGj9JnVZNhHIEG3HBbMPoUOZwQg1WWLiLd6Jh+9VqOXgCNs4qrEADd2RlSUsvbvvqGO19Wq06fHswaMy4Aiz+YdeBfeqcmEyVvxW5Zt4ieQuPBqPYMupx0gsn+7UQYfd5JT7gchWMlOAyZNttOErDevWqz3/tHPl6v2376o3rURup4t4l14J96Nw0nelXfuXDuRT+IXTxfv7zCnfTwaLrwxrQCpJr88EtrsxDA/4YPmNaOmwILlusVLZCU7sd+v+c496sThNndGd6ZJ3ulEW5uqlXfkXXWCVnksfbf31olCEH4ACbrm0GDfXG0zj91mM83QDTLouXwyzK2VA3BY7lu2K3ioqsTWaH76SfXOyEjuASOJ3hkeUU3yrlWykvDureUTOe6W8e4HD5dDg/2c7IhuSAtAalaHsT1+6iScxOs9ARPBwd6d6FkLHJWri1X7lt+KYTye8+BItE1458pVS+IgsYx3v4AEnXMYhrIZ3U4ZIIK0650UYKADqh/OOPrNymGFqWtw3CLbrNdezYjbNtPEOx/d8aqeA3PBwcDcmCNzHfJB4CO656TQA7qdEkAqlpewejzgZPXwcqvleGE3462ykpxQqBpRrGM731Tjf/AeOOIgkXdnDswluRAEc/b6qfuI81XkAXTcNUCqIoWflEF67D6wS75KXq9dNZgQZMg5QqMoUuXKxero77Z7cuWYdCWRuVSvWqznpjMUE7ZaXq+5hQ46AEgPOu4aIDWRwjtkkAt2Vw8vF19I9Fgd067c4niCU+svfq7GCRHxODjiIJG5HHr2P/Tc9idUS7GqN3rZHhl2topcQMddAaS6pIASPrvsovHIqkWe3loNJRR2s+wO3KP1jz6i3n3nXd+AI35WInNibsyx2aTx9prCdF63R444s0V2oeuOARKNFIbsptKSVD/k4USo0YTSPLhAMWA5YKv88no13NvrO3BYxNz2yRw58GTOnSZ264THK6agiw4KPXyArjsCSOUKHVayyS4KD69c4PnVg60VRnmbOQjE7ujet9e34LCoe98etW/l4vhWy7JHvL6KoJMOVpFN6LxtgFSFb/qE3NRrN+ZqYF3Y27aHfDH5crbHK48sVE0//sesBhzO2BmJzHG/zJU5t6yO5ZX0Gq+Wl1cRdNJBjFYvOm8bIHJDud2EqAPLizz7pRnTB0yxsjydprACRREq161QJzs7fQ8Oi5grc2405yMTq4h3PVroJLrpoCJjuS2A1K0rBSDP2V2ejnm4vpW1emB7sL1oMF6rQ7/8RW7GV2Uwbos5Y7A3mq1Wjw9WkWPO6mk9h+5PCxAxWK6Wi/vtGudedu0Om9XDKqwQFQXZ9xdr1UhfX/6Aw9DI8eOq8i9ujRenswpCeLmKPLrpwFjvR/enBYiT7VWbx41zfP6W7cGXk7pTB/7fv+TX6pGwihyUue8VHjSaGsDwxuu1t9rsG+uTbrOSU2pnO0mK6lvr3VzzEePa5YCMkAtcnRWritWJ9vb8A4dVRV7mvkt4gMEOT+CN112+6KijZCrBwJQAqQqHKOfTbT/uqtSzqwdbBw7FiEXCc4XtUfO9+xxVXfcbMXd4AC/gCbyBR17OZefdHZysd4OBKQESDRcusNt008vbKytil/q21vaKrcXRN9/IW3BYBA8St1nwyOtVURxssz4GA1MCRC542O5y5OV8c2t71X3rxPZq95qlakgM1XwHCDyAF7VmmwWPrEhfL+etO9hmPTwpQKqX6LTaXXa9VyMe915ZPTusmKvoxu/OaMGFXEqughfVZpsFj7zeE3HEmTdrF1i4DCA14cLr5I/DdgsyeLoIsukZSFYdIRb7ZEtx8D//Pe/BEa8DLLyAJ/AGHlmhJ16WuYPCDsNg4TKARCOhiF33rpcPB62egb36cDBWdX3P8gXqWHVVAA5D8AKewJvDCaEnY/lxaHgeLFwCkI4Xnyf3Y6Pd2CsvVywZuz3WkrnbJETVAZBVxWqwoz0Ah+XuFV7AE3gDj/zQNFRXPrFvh2wEE3GAVEVuxv7YZrtaoocZhZD7zek5Rignx7vXl6lTPsz5cEvwAp7AG+s8BJ55Oh33dkdVGLeBiThA5BdX2Q0vaS3ztv0xdvulwYmEl1R+7S/V+NmzATjiablnNU/gTUtC2InXizqgu3bDTsBEHCDRcMFn5Bd/tHNzp4fzzq0zkJiBPuHBqnvwu77IN09n3jo8qU44MIRnXi8N1Gk/X/2PYGICIJHQF+3mnr/t8VYGCJkqHnhnKMmp87KfeFyN52P81ZTVT85pnsAbeASv4JnXAYLu2s1VBxOJW6zH7JYUHfa4u4+9KF6ZDgMQ+gY2/+yn+RmgeIXAxeafPR3jTQJAvN5VF911UKL0sUSAbLFz0/5lcz3fRo3YHAsgTQYgB/79XwOAJAEEnsCbJgMQeOb1VhboLjpsEyBbNED2LS6cY7eVs5fjrxIB0pMAkH35HOJ+JYAIT/b5DCAO47JawAalRa+V/wzmg4GeCBAC8ZrMKXqwxZpsi/VTA5BFuiRQj08A4sBQHwQbAOSz8p/3bQUorin2FUCsMJP9Tz0ZACQJIPDECjfxE0DQYZsAeR9sAJCw3Z6DJzxcveRKAGn85x8FAEkCCDzxI0A4Ubfd01CwQZDiX9ouDudxD9ZUAGl4/IeeLkqdiSLX8MSPABlyEtkr2LBdvd0vDTknA0j9D38QHBQmHRTCEz8CxGHjzwcAyE/sXEzjdj/0HJwMIHWPPhQAJPkk/X8/5EuAoMPosk2A/ASAvGDn4oMrinzTOjgZILWPbAwAkgQQeOJHgEDosk2AvABAdtq5+FDZAt8CpOahDQFAkgACT/wKEHTZJkB2ApBGOxe3+6Ax55QAefC+ACDJABGe+BUg7fYbfu4HIMfyoffgFQGy8TsBQJIBIjzxK0Ac9DI8NstuHnqXD07RA4AEAHHYpm0YgLxn5+JuD+ehTwuQ7wVbrMsA8j3/brG67eenvwdAPrRzcY8Pwkym9GI9fH8AkGQv1kP3+xYgPfbDTT4EIB/ZubjXzwD5/oMBQJIBIjzxK0B67QPko1l2Mwn9AhCSZi47KAxO0i8/KPyHy0/Sh30QauQQIBfyEiDdCQChDm3DjzYFsVjJsVjCk70BQC7k3RYrBpCJPui6UPNP/imI5k2O5hWeWADhY9KdnwD5KO+M9MkA0vRvPwsAkgQQeOJXgDg10vPKzUu4M4XQAAhNK+kqdfBXvwxAkVyfV3gCb+ARAIFnQz4BiFM3b14dFJ40AGmjaScAWTZftW7dEoAiieAJvIFHNPWEZyd9AhCnB4V5FWqCkLtMXV6Ev3tZkTr88vYAFEkET+CNHwHiNNRkfz4FK1oAOSQAoSc6SnBkx6sBKJIInsCbelPAustHAHEarJhX4e6U8e80havryhaqXaIEnRU7A1AkETyBN/CIjwk8g3f5GO6eNwlTFA47sZ7GncVxgFSIEvREg94gyQRPKgxA4BX1eeHdmA8A4jRhKm9SbhEuvUEohNYihhp9+N4qLVL9ra0BKJIInsAbeASv+KgM+AAgblJu86ZoA8KlzwVVFalaTj/wt5bPVyeD5p2XETyBN/DooPAKnukeIXlXtCGPyv5YvUHw6zeb3iAVa0rVyMBAAIokgifwJmpaIMAzP/QIcVz2J58Kx1m9QXBbNpneIHvu+IIaGxoKQJFE8ATewKNmAxA/9AhxXDgun0qP6t4gImQOCQmhoP9F5bfvVqfePRWAIrkNm/AE3sAj6vPCs+M+AIib0qN5U7ya/hZEpeK2bLSyCf/xsSAOa4p4rJpNj8VD3g+ZZp4j+dNlKla8Op/aHwyJgdZtehNyAKYDFf9lcwCGKQje6HSAlTFPVreOx/I2QBy3P8iXBjq896A5RcforNOn6PNV++9/F4BhCoI38IiPyUHTzBMeelkHHDfQyZcWbHhf6PV9dE2sL0i0bIGqWL5A9TTUB2CY6rBQeFNhXL20YoN3Xu6X7roFWz408cS4tA4J2VNXA5DVsmU4diwAwxQEb+ARHxM+KkfMYaFXDXXXTTzzoQ30SMIZCBGqeGf2fPk2dfrMmQAMU9Dp8TOaR/DKyguBh1411F23gaZpummePu3NNGP36vJKp9Y2E4O1N+gL4qhPSJ2J6j3u4cxCdNcmQMDCVXGAVEVuni2/2GY75MRjXxD2zESidq8Na28MWwYiVTteCQz06QgeEfZeIzyLebLCmpdes0PQWQchJtvARBwgHS8+L6tI4UZbhro+UY940v7oXFOiT4UrZctAnNFAW1sAgmkIHuHMqDIn6vDQi3YIOltrDxzQRjARB4gx1CPyh/N2Bjjmofx0vnTDJsSE8jUcEJJrvedrd6h3RkYCEExD8Giv8EpXfzElgOAlPPXSKnLMfh76ebBg4SIOkJpw4XV289NbVsz3JkDWTBSLa37m6QAANumA8CpeRG6NNwGCztrNQwcLlwGkeslc7JBddiN7RzxiqCUCBDdlMy7elYvU2/V1gfLbJHgFz5qNq9drABlxEsErGAALlwHEeLMetjmIOr622HMAISuOMJOmb9ypTp86HSi/XXev8AqewTt46DWAoKs19u2PhxMxcQlAouHCBXLBx36Ky7IAgv/eKtbQ91pQpMEp9e14RfMOHsJLLwHEQfzVx2BgSoBUhfV5SLedwRpyKMNwzAhr1BAuPd6N5BhckgiUsjUcdB366pfVWE+POjU2Fqd3A5qUEnkEz+CdVUQOnlLlBB7D65EE/o/lEHh4twb77t1uMDAlQKpL52GHPGN3OepbWzKjoLDAYAEB92O/EFsADrQIz+aLd8QUaWAPXX9bmaq/5+uq5u6/VZV3fU3t/ubX1M7/9VX1+je+ql79xt8EJAQv4Am8gUe1wit4Bu/gIbyEpwAFHsNreA7vkQGy0MBJAMxM6Qk66mB79QwYmBIgxg4pt+vunaltlgWORGAgoF4RFHV3Ow0ocEly+ssBFx4YTtB1FuHy+aqitEi9sXSeeiUyV20vCaktxYXqt0sCguAFPIE38IjqJvAM3sFDeAlPycyEx/AansN7ZPC2yCIRKDMJEgfbK3S+PBkPlwEkGim82m7YSd0MRPeOmVWD5Z0vFl8vvmQYjwiLfTKh2QTXIcj6lYt0ZQ4OB/HlI+w3S+ep10Xwr4oCvFxys9omyvD/A4DECV7AE3gDj+AVPIN38BBewlNi2uAxvIbn8B4ZcJiITJANMjppVpNsg2TYmfeqH92fFiB160pZRZ6zuyxl89BQG9yypyQngT1wjwZGsf6SWaAgd4EvXeWK+VqYhEkg2J0GFDtE4HwZfxe+OQ6OYPWYfBWxQPJ74RU822HAstOAZU8cMLHVBd43JawuyKbH2CuDMwASB4eD0HPo/rQAcbrNOrC8KGuTHjExVZSfIbutw9gWevtUXqz23/tNdfiFX6mO372sjrz6iureu1t1V+7VdMxQl0X79qrOBDoa0CWUyBt4ZfHN4qPF1+49uzWv4Tm8RwZaFsZWQUbIqn/dxEqSrY8pupnK9mpKgFSFb/qE3NBrNzZrIAvVTsaMzcHXiH2uZXgT/rD/3rvUQGODGj/zXuCSnekIYJEBstj/7bu0bCyDHplhlyDDbHxQ0UkHsVe96LxtgFSuWMQqssnu8nQ4C8a6jsg1IevHTHV2vlLND35XjQ0OBsqZa2WDRCbIBhkhK2TWZ7Za2QDIYfvGObQJnbcNEGOsh+TGD2wXlVsfyUpEbs+tMbsDm6PhC+VqOMgIzFlCNsgodgI/sYpkOhIYXXRgnH+Ark+FgykBUl1SMMdubBZ0ZNWijNsf/eY03GrA2fHcfwYle3K8dBAyirdxM7ZIpu2QI/b7f8Rir0TXHQMkZqwX3mE3V53Tyky6fDkRxf7gS4Q7kQy3gab9gRLmej6JyAhZJYapZBIg6KCDk/ML6PiVMHBFgFRFCj8pg/TYRWMm27QBEPaw2jin8PSqxWqkry9Qwlyv8SsyQlbIrNPYIZkEiIP2alAPOu4aIBXLS2xXf5+Iz1qaUYBYvT1qVy5Sw729gRLmuh0iMkJWraYJTyYBMuJs9dDV29Fx1wCJRfiGrpeBRu0+NFO9DK0tVqcAhDqxHEr1VVUGSpjrUcAiI93GzfQ5zOQW66gz22MU3Z5O/6cFSM3qMKvIT52sIplokzCZkd7yzz9S42eDqiQ5eyYiskFG2TDSh5yvHpvR7ZQBoj1a4dANMuA5uw/vyEDDz1FTGTHRzVt3S1i9XVsTKGOuZiKKbJCR5eblDAsZZsLN22G/MSd0TlaPG+zovj2ARHQ67ma7L0B5x3RXPhkzAYp9JsxEN8ER46/xzi+pEwcPBO7eHHPvIhNkg4ys1cPKIUn3QaGuWGL/3EOvHuh02gBibJEbZeBxuy9Bknw6GWEFKlqh7USMtukcDw4MV6sjL/xKjfT2qPH3zuqlPaAZIOE9MkAWyATZIKNOk6ZLHB0yTLdeOCjIAI1Hw4U32tV72wCpLtPhJ7aTqWozkLc+alaRARPmDuOJGqVaO3E/dWtLVf3X/0o13H+vqt/4bVWbTXrgXhUVqhaqlOfvE9q74V61Z8O31C6hCqG37vuW2in0xn1/r974rkOSe7iXMSrMmIzNM3gWz6w278C7ZHPu8FrzXHiPDJAFMkE2yOh4QhxWurdX6JiDmKtYUlTZollpB4gpDYRH65Tdl0l3488xY6wPGZD0mozBxDwQKzGqxiRHQVVZIpKKdunQ+lgylhVar8Pqi2M5J79ZUqh+vbjAFf3GytUojoWhWyHob8qz6Ei7yyQ2ZWu+Fn/htZVIlZgXgmx6k8AxlmbPZqMzw/wUOuxE5x0BpLp0HgXmHrLb0zATBntiwpReSdZNnjDF8r7fJE1BjRmmBpOYdSlQ5qnXErIWt5q8E7cA4d6tAg4r2+81k5uxy+RlVCUkMmV6vom8hdeJwDhqJUyZzMJMJUw5NMwvorvocMYAYmyRa+RhXU4M9v40t0ywUm6HTcotrkMrD520zy6TSKXTbrNEGKKJqb3RhAxGkoxIOnrJZC66BQj3MgZjvb50IsMvmpSsxLtka97wGF53mZTbeH76uoixOTKTcotOOTTMu9Bdp/ruHCCPPkqM1p12E6ogGrdnogLKpYUbYqsKQjlh8tTxmrytwZN54mtpndFYW72Djz6ijm7fqjpe2qrahFq3bVUHt21RzS6JexmDsRiTsXnG/gRgdJkvdzbmbPEXXsNzeB+rdFKa0YIN6BI65QAc59FZdDfjAIklVBVQHuhNBy+o2mU5zFbrrrGEUkDZolGTr8Kemy8q3pvuF1/IuEu1+ze/jnuKePZgwhc7q5RF2bY721pBb6KzbnTdFUB6d+wgX6RIHnx2Jr1aOVeg7rbSS1zQHT/+oTqTyf4jMnbHjzfpZ3WtNRUPbyv1bIu0DHmtzqKr6GzWAKJXkWU3zRaj53EnBjuhACfXR3wPEIxUDNamO7+kTo+PZ7AD1LhqlmccyhOAoDsOw0kwzB9HV93quWuAmFKln7LbQtqigyuKPN+M3g5AiF6tLy9Rg60tGQMIY/OM1jwACDqD7jjcWrWgo6noeGoAwWAPh5bZTc3NZKxW7gEk1mr60M//NTNhMDLmoZ//m34Gz/I7QDqc2x0foJtuDPO0AUQfHi78c+K0nnCy1YK6PdSEx1HE8fqJOsB4l2q+tEYNd3enP89CxmRsK1K2xxRpG/EhOLqd1bdSRhefQDdT1e+UARILZtRbrXonk6jT5yNh320DcHXiZiU5SG+z5At/8InH01qSiLEYs16vHlYiUizWyW/bV3Skztl5B1SPTqZDt9MCkOYfPEKcFl6t004mgsE16COj3dpm9evqK7GWbwdMevDRbVvSkrvCGEdf2qrHZOwOs3pwFuG37dWgc6NcGR0sQidzBiBQ7bx52CN3OzlAtA4RvdpWeMrqj6bAXZep38UJd3Rtqep69fcpgYR7u3a8osdqMvWmuhLCyP20vUInHB4GWhUS70EX06XXaRsoVkvrZkoFPevUHsE7kSu9RtKzisRO82OHhrGtFvFR0dVLVPsvf6HeHRl1DI53R0dV+389q2pWF6tGk+N91BwOnshAGPlM9/Rw4bFC555FB9Op02kFiCk4R6xWs8PJqdYV832zfx5NyF2xQHLI9CfB69Tw7btVb+VedcpGGziu4dqG79yj7202QYEWOPy2tYJ3rc7yOyxqRvfSrc9pB0gsLL6gwEmhB4sOlS3wFUgSw/KP6twVUxGS1WSl2CZf/2t1+De/Vn0N9Wq4r0+NDgxo4md+x9+4hmu5h3vbTbRsbwZzLGaSZ+iAC3CMonOZ0OWMAKS6fAlG+3qn5yN+BomONDZRxm1WkpcofU2Zya8Qo7tq/YoYyc+xPIsF+hqubTPtBLpNR6cAHAnnHaJr6JxnAALtJo89XLjBblNQv4Ik3tNk/dJ4e7juhL4mROEm569Y+RXYGVafjW7jyrU6N/ltW+USHB/XhEMbdtvML88pgMS2Wp/7E5nE006Ndiun3U+Ge2IvxX4THo/Sx9rFxZK92g1ZSUe6rZkJX7fyK4ZmqFtTJg3yFnc2x0WtW6JjmdThjAIkdogY+lOZyFY3IMGT4RcX8GRNR/tNzgpbplh/xRj1mm0Uf+tffzkw/AIOZOvCW2WBYyu6lWn9zThAtGerpPDPZEIVLhihfeGDPosATswh4QuKokxG/G3UZ6BIPAR0cc5hUQU6lQ3dzQpAuit2zoqGC66TiTW4YQinqX4LS8lnQpYuTsgtakCX0CnfAGSinULB9W7OSKzYLT8GOOYbIUMXsVXxsw50KJs6m1WAHHjwfnJIPi0TbXXJIB32POrjjDm/EjJzEbKeSK3oDjrkW4AkJFp92u1KYhnvfs1M9GsmoEtjfOKUXHRmJnR1RgDCV6A6VoSuwS3T2MOSnzwWKGDuOiNMDnkK9oa2OdCVbK8cMwqQiZVEG+5vuXEBW4UgqHDhl/MSXyWPiUyQTa17YKATb6EjM6mjMwoQPBHydcAFvMUtSCxXcLqL0wWUWlG3FFy4Fji2oBvZ8lblJEAmIoD1YeJTbsJSEis4dgSryYyvGsig1r2XShkdeCqahUNAzwAkduJ+0xziatwEOCYXzNa2SeDpyurBJzxvTM3WsAotbEAXckUvcwYgVoBjNKyjgEdSYXStieVKdxOfgCZvXgOva1MDBjSC7DMZeOh5gOiCdGXFJF0VGDfwxZSAYrZdQz5K6c0Vgqdp2E5Z9gbJTgXIPtf0MedeKMEuuUaW22ed5rhP5RKmA+pIAJS0tFqGlw2pb6diOeRh0mRD1+SqHuYsQGLNQ2+eI8y722m1lCsBpbN8sa+KRGQz8hbepQkYuvoIskXGuayDOQ2QeLWUWEmh+lS3XIlAOSJfwaHgNH76rZTw6Ej6VgxrS4Usi9JZfSRvAWLV3TLF6ajg+N9pEpQOmju8coEaWBcOvF5JXil4Am/qUrcxEgnZPYEs01W3KgBIYjTwgs/NFmOu1BTMvpguweGBObC8SB27ZUleb7+YOzyAF7XpA4W1arRo2YkMvaRzngKIVTC7OhxiNXncSX8SJ6tKm3w5+9aW5MWhI3Nkrm3pXy3i/TmQFTJLtZB0ABAHtHdxwWzTxGdnOjxdU9kqKA6HYH7ygDEX5sTc0mhbTFblcCcyQlZe1TPPAgTq276dJCzawd1pGotezJCw9deVAzG2IByOeanMJ+/KO/PuzCFDK0XidqorJpOCq5CRl3XM0y+fuO2KloSuMS2qT2VQ+HGbhbCK1rL52vX59q0lev+eC6H3Y8aW4J14N96Rd63NME+sPuTIQMvCg9sp3wLEosrlRXTgJc/kGaEzNdlRivip/f5lc9Uh2bagmMfXFMtXOxyrRpLmGlZjptgDY/MMnsUzeTbvUJvZFWIyOhPjeeH1TvuQBwCZibOT5QsJV7lRhLZZ6FyWleWyrRlfcMK/yaqjQBp5EpxGd4lSk6PdIwrem0T8jr9xDddyD/cyBmMxZl32gZBM8HYzvIbnftQlX04qvvVaOo/gxxsMUMZmWJn8RGMaGMJbeOxnHfI1QOIrysqSWTWxFN+NQj1CFwIld0wXDO82wkt4mg+6kxeTjLuGVxeTwfhJ2SvfIYLelWruSZ7QBzFeFd4B7+BhPulMXk32klP5JYVzZO8cEuFvEurN1FmKR+m84ckmzSPhVb7qSd4CJO75WrFoVlX4pk+IMpQLPSfUn6dgOW/mDg/K4Qm8yXf9yHsGJFLdulIOHq8WBVltXMXdqeTJe4A+NnN8JjbngqvhQaALAUCmz0VZNnd2VTh0lWwxFojyPGxsliGPry7nzRyYy8PMjTky10DmAUBSoj3hgtliqF4XjYQiolwPCG0zW5I/5qhX7IJ5t37zrg/E3r3wOuYSyDQASMao48XnZ0WXhgQwoatqwgWfkX+/KPSYqe9FKP6g0PuZjA1Lin163zyzxbzDY/qdYu92Fe/KOweyCwAy80UnwgVzouHQtfKl/qz8WyIK+hWh+4WeFHreRB83moC+YaH3hD4U+sh89S+Ynz80fxs21zaae583YzHmV2LP0M+6lmcHMkgv/Q8ZeKFBAafmfgAAAABJRU5ErkJggg==")
 	logoGrafana      = string("data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAAT4AAACfCAMAAABX0UX9AAABhlBMVEX////xWilBQEI+PT87Ojz5sRL5tBH6txD6uQ/6vA/5rxL5+fnzbCQ1NDb6vg7yaiX2jBv2kRr3lhn2jxv3mhj4ohb1hx3v7+9JSEr3nxb4qhP1hB74oRbzcSNOTU/c3Nz0fh/MzMwwLzL0eSF4d3jyYSeqqqr7xAzxWCZiYmO/vr7xVB7l5eWLiot/f4ApKCv+8++VlZZbWlz7yQv97ObwSgD95t9qaWvyYBSfnp+1tLX2jQDwTxLzd1P1i2vzb0byZjf83dHzahH4spH1fQb+8+b96tP+9M74tqT2mH7ybED3oYr6y7/4r5r6wrHzeln1kHL4qpX1glTzdT4dHB/zbjbxWgT1jWD0fEf2mm71lGj71MT3qYf1ilL0fz76x6/0cgD6v5383sj3ml/6zLP3omz2kkj5tIX3omD6xJv2jzH4s3j82rn4qFf4rWn3nTb4r1T6xIn816n7zpf94bz5wG74qj/5tk37yHf5tz381Y77zHD95LD7yj770WP7yjb93oj+7LsjkiSNAAANPklEQVR4nO2aa0PTyBqA2ya7egwRVNjWW2pjraVpS1tLSy/cKikgiLru6rrIiqi466rclD2wXvCfn5lJmkwmkzTtejbFzvMFSN6ZTh7euTaBAIPBYDAYDAaDwWAwGAwGg8FgMBgMBoPBYDAYDAaD8e0zW/K7BceZheDMit9tOLYIi/Vksr6Y87sdx5PctBIEKLeKfrfkOJKbqgcRyiTz1zmLuj3g747fbTl+LChBA2XJ79YcN4rJpKkvWF/1uz3HjCks+eDwJ/jdoGPFbBBPPuBv2e8WHSusyQfTz+8WHSvGgwTK7eUSvn7OlWaXl2d9a19vs1An9QWVenJycUVfAa4sTivj9brCNiQ2cstT43Z7kKRSH59eKQUWknUFDY31e343ttfI3Z5WFKo8XWF95o7SmlaSkyz9cHL3gvWkizzkzAxIBvt49AOj/8rS1J07U4utaWFlRmknz0q9b4+zVpfuBMfrikZdmVycLU21zTySPt3NCQvJccWSaXBacBvzHPRN+f0kvlCc6TTPmD6MUgf6kgDQv5NJShHlrt9P4gse9QFv9Xrw1vQdwOR0sF4nZ5b6gt9P4gteOi9IueStxYXZUrGYgxTBRH13RrGsCpVFv5/EF4rTbVd39eDiMuWQvrQwhefgeF/uO3KT7vqSyuSC44aitHTLFKj0ZfclD6SIzJtZdj0eLd4zVj1JpR8Popdc9Ckz7TMqt5RUWv768EWEFfqZCrI36cnH6rTuT5nqv3ODku08tMX4ktevNRb1PV4/HlvRFsGwKyZve69jRf9CpN5/3XeRPviNd2APdGBtAOzDrdsyVV+9I3tgDLiFqhnvu+/hcrSFc+dpVLoGq0n23/dwt+vXSJLTnc+hs9eSoKS3r4HT0VgsGu74I3qSos3etQfdHL2vKrDoj+3CwtW4FAlxXChSyEe/hVcW7k0Q9ia62/+jeh64T77hvCTzXAjCcaJYjnX1SRgCIOxrIgs/E/ruO3Xd0urC3R/v3//l4d2FVYqmh8DfhNupvZCQRc2dDj9WiHbf7lScH4P8FO+6jq/BMtF16Vu13PKvP09MaJkKfv788CZ5DlOC/4cfnYfNcJnX8o4XAdrvfCjRZavTcV6rIiR2/y/4GuQeXhs0cUi+lfsPgDozCiq8S5wSPHkweG3CcdyMFnjkTpYyiVQ1Eedl9LeY7arR6RDfSuG4v0NoaRBngpZ8s79MDNqZmHholQWiJm46fEpa4lG25Vu5IlTLsCvzo920WUCZzMuAn1LdVPD1WLGomaAk35NBmj0YPGCxtTowOLhG/xAB5Z5YxjuakArxfCHdTZtTMrQ3mooBfJ6/Hw7gQh7ZAx49oMuDPFjDJ5FfJwYH6B+SEaG9PHE1nJW7G7hg8ondDptflwGchv3U83FjwI3LWAeebQw0qINfDM64fMV2XejOXrjAhbjRnlg2bljs/Gb7XuMmdr+BIP2ZxoW1RuM57UNg1+1ykqCRlriQaP9n+MHTxmWThq3vPjduNxprj28+vfn4198aeBFww/T3pNF4QvmMGOi6XKTLBgrhdJpYGUcjHK3vUiIt96jp6lbIA48t+p4Sd3O/te5cfjGrzyrF2UeXrQKNlM1dbtCm3gxIPr7apiHReDabCgixSiYLaC1Hovl4QZJG4wnwiAK4noGPCvVxoxmEkdPRCiVSqKCq0pVyQSqU87Z5ilp9BwhrlkQi+25LbuOF5U7uKS6wsWZM189o+sLwaQvtGhYb4+VENSKLPEDWthKx8pjIcwBe5POCwPNipKUPLCERY3rpuBEp58MC+CFBV0Jc5MfSee0euJWxZKC1+oAMCnWoL3cV13eVuFtq3SWzMlB6hPt72br8nKYvJlNmXVoUJ+k74hAHt8NCpbWzQLuL0VgERBj6dGRUuBLCIwsoUtPHg/8cj1ViJqCt+pBefQcU169ikKu2m9pd6nzwAitpzLeldYq+BBj65LbHA1Ayp+3qeBFNq2i1o2UZmrkjFn1o7yeKKPvyMmeNDGH6Qq17yFLZyD9b9V3oe4rrW39J3NVurttyz1Z0rdWol5SpAw59otmudMxGVNeHtnGFcrmcaj0eJ0bimUw8IupZaejj49UEAtlDkSEtUj/SMfWhSrOZOMpQYwHgXL1nNp7huXd1nXj2DU3QM4fSL01/69T1ig7sQBFz0MnLPIEoGfr4QiwsCDA4hh6Pq8AJU0jn9cw09GEzbwztnkN5ItLUx0WqcGqIoj/0lbpWfYhWvVdeWnquXYKWX1ccD/E2zaKbbfRJmD4xRMAZ+nijb6FtHi8Z6+oYGu9o+oRRGBkxIqMcR+gz9jbwLz4DfwuPOlfvjeKz9StW5gh9L9BFskeblLCyG84fRGafyGEgfYWAPvZJxsieQmtFbFcSFR30wQ2wNRJNzKY+c7sD19vaGgBVL9Gr94S6OXeFxOogtwmvrbuIeWlU4SKZHPsqPJ55EZhzcJkC9WmZgYjbtrV5ka4vCyMte5CKiOvD1cKmhOAN+67ZrN4L6pzdHqFPfYXEuFSSM+u46BzlOvMaqxr0i7m2HoPJYIlEqWPXhzbAEctjCyHOsnAxb8KmoPNVWD3vUL0HaLl3ce61JaZ4EV51G9UCL8z0c05SJMZpiwr/6WJKj5KNw7v0mJ6UGGgMtelD03DWuiHL8ri+Uas+OBKmZZfqPfD73EU7xNhX/AFe+9OtmudGNXN/OAaF4VDkcEASHuX03mXVh7oysdTOUPXFQvZFOei97vpcq2/PHzR7pAL1FbzmMqgFAs1XRtkXzlFwdOLpp8IpIw3s+kgpLvqI3PakjyzkXV/zByqEqtwmvOaafYHNi3rZi787f0uEprkC7Y4ABq6QjEY8SucljrhcOm/GGplp13mjskv1bfmdru8HYpz7E15741rTplH2leocBVcSMm30y6DpDvVrqz44tnNdTx0Rro2+fzR1vB5y0FezDlB/zIFrQ65VvTH1NZ2jqmhRZT+yQtf1oyxCH1zWipYOn+DpCxe0xLHUXbUuXGj6wJBLVu954XJmyAFi+lTnwLWay7oP6DPKnnPRp+3cbUd+CQ77ro3QhxTgp1xhnqPrq8rEc8N8bKfPrfo2vK456asRAx2S89atLm/60JEf3K7j55XprBjCNhqEPuSAN588Oso7bdpQpKkirH2j7K7PVn3BrL4Nf9bOOTD0xjr+b9SGzg3VXjvUA9kaahU946YPzI/oUEiqtASm8xKyZ6ynCX0oqeAJgvZXSju0ox4ZaENAIUVEuupzq74NzvrOkT0VhZ5xnhTULaPolsvUEYD+tLcKZClTSVQykva+Cx+xLFVwfQEtOWUpn0iA8NYxFEWfEZmxRLrrc6m+HVu1Mw7UiHlW3YJXnc1smEW3XLMPPLOEveOinyrz2DtCNn3aqwQoHoVzDmMf/L5YtEZ60SeURYfq26FuO/sjJDRhZG3Lqf/umhXV3rinXyCcGWudxeseZfybGZs+YGUMi+fFhMTR9QWErGyJrEba6yOr5xOS513H29pZOjVyomhuwdDa9mtNjrq3jVvaxotut/EXiGYjMq+dxgF3kazlS/KYzHEysTVJFfT3ATmej6eFCM+39HGy9YvK1KgRKcfTYKHJt/RxPK4PfojxsVWzkAgKgR9e97w7DvrO1t4Rkeo+Ul3beruzs/N2uIbnp2r5L5DzNoV0Ar5dGgpFpHiV+NYwFpKkCLmzC6fK6GVUKRsTwBQrSWiCjYJfIgkyMq69tgojAzAS6ctKklQ2nVQjkhSKOlQv6dV7YWO4dnaYwtl92+ZrdxhaGtYUDeP9eKdmLew2R5ttjkajHbwYJMBXoT29kOE98p8WAqg7NHuAHVtobnd7C9obPr+/h3dQdZ8out95M44vzW1q/g2T3ReiNjd2dzea1szc81T020Xd2R8+b8ejhKa97Pv/b3t7DvXd+/3zlwgJI+2mUI33dvEeS35TqO9GLlnZb7MERuydP3+JxGviflvsXRqxcGmkvYbdEQrnD/+F1vYeF2wi2vl7R7N36S8vafvt0bT7e+86jO3R7F3Y+7fa22vsjVwgGDnYdYxW35PBwN3BXh/OGy3+IvUB/nLowbsHZOB/D9/1Z7dtoZJKEAe7NivqO1tkv632aDRP0fxdOPX+EDc4f2jXfKqPO61J88IpKsDPwafDvd3dw78P4B82+nKtZ2PeQV87+nOpZ+Pw1HddcOpvv9vdI/zdjb3vmD0N9eD7zmH2WqgnT3Qq78T3bNxroZ7omJMf/G5079A8cbJDTsz73eYeotmpvZMn/G5yL6H+p1NO+93kXkI9TQIEufLR7yb3EupHUs/R0cfrbvqO/G5yT/Hp9HWM05/hxDD/5dNn09d1S8D1L363uKc4wvSc/oi5Uee/fPjw4fDouhXWdy3Mm/o+faGdQRH+jv7tBvY4SMqNz0dUd5BPuL0b7JTPyvyXL/PuTo5uGFxnO47OmTf0sZGvKz581vSxrtslYC1z4wbb7naPOs/sMRgMBoPBYDAYDAaDwWAwGAwGg8FgMBgMBoPBYDAYvcL/ACq1q3egtQPHAAAAAElFTkSuQmCC")
+	logoThanos       = string("data:image/svg+xml;base64,PHN2ZyB4bWxucz0iaHR0cDovL3d3dy53My5vcmcvMjAwMC9zdmciIHZpZXdCb3g9IjAgMCA2NCA2NCI+PGNpcmNsZSBjeD0iMzIiIGN5PSIzMiIgcj0iMzAiIGZpbGw9IiM2ZDQxZmYiLz48cGF0aCBkPSJNMTggMjBoMjh2N0gzNS41djIyaC03VjI3SDE4eiIgZmlsbD0iI2ZmZiIvPjwvc3ZnPg==")
 )
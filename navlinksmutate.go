@@ -0,0 +1,118 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/golang/glog"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	uiv1 "github.com/rancher/rancher/pkg/apis/ui.cattle.io/v1"
+	v1 "k8s.io/api/admission/v1"
+)
+
+const (
+	linksAnnotation = "navlinks.cattle.io/links"
+	groupAnnotation = "navlinks.cattle.io/group"
+)
+
+// mutate annotates the admitted Prometheus with the names and the group of its navlinks
+func (nls *NavlinksServerHandler) mutate(w http.ResponseWriter, r *http.Request) {
+
+	arRequest, ok := readAdmissionReview(w, r, "/mutate")
+	if !ok {
+		return
+	}
+
+	operation := arRequest.Request.Operation
+	if operation != v1.Create && operation != v1.Update {
+		nls.response(true, "Navlinks annotation skipped for operation "+string(operation), w, arRequest)
+		return
+	}
+
+	prom := monitoringv1.Prometheus{}
+	if err := json.Unmarshal(arRequest.Request.Object.Raw, &prom); err != nil {
+		glog.Error("error deserializing prometheus")
+		nls.response(false, "Deserializing failed", w, arRequest)
+		return
+	}
+	if len(prom.Namespace) == 0 {
+		prom.Namespace = arRequest.Request.Namespace
+	}
+
+	navlinks, err := nls.builder.prometheusNavlinks(r.Context(), &prom)
+	if err != nil {
+		glog.Errorf("error building navlinks: %v", err)
+		nls.response(true, "Navlinks annotation skipped: "+err.Error(), w, arRequest)
+		return
+	}
+	patch, err := annotationPatch(prom.Annotations, navlinkAnnotations(navlinks))
+	if err != nil {
+		glog.Errorf("Can't encode patch: %v", err)
+		nls.response(false, "Encoding patch failed", w, arRequest)
+		return
+	}
+	if patch == nil {
+		nls.response(true, "Navlinks annotation unchanged", w, arRequest)
+		return
+	}
+
+	resp, err := json.Marshal(admissionPatchResponse(200, "Navlinks annotated", patch, arRequest))
+	if err != nil {
+		glog.Errorf("Can't encode response: %v", err)
+		http.Error(w, fmt.Sprintf("could not encode response: %v", err), http.StatusInternalServerError)
+		return
+	}
+	if _, err := w.Write(resp); err != nil {
+		glog.Errorf("Can't write response: %v", err)
+		http.Error(w, fmt.Sprintf("could not write response: %v", err), http.StatusInternalServerError)
+	}
+}
+
+// navlinkAnnotations returns the annotations listing the navlinks and their groups
+func navlinkAnnotations(navlinks []uiv1.NavLink) map[string]string {
+	var groups []string
+	seen := map[string]bool{}
+	for _, nl := range navlinks {
+		if nl.Spec.Group != "" && !seen[nl.Spec.Group] {
+			seen[nl.Spec.Group] = true
+			groups = append(groups, nl.Spec.Group)
+		}
+	}
+	return map[string]string{
+		linksAnnotation: navlinkList(navlinks),
+		groupAnnotation: strings.Join(groups, ","),
+	}
+}
+
+// annotationPatch returns the JSONPatch setting the annotations, nil if they are already set
+func annotationPatch(current map[string]string, annotations map[string]string) ([]byte, error) {
+	if current == nil {
+		return json.Marshal([]map[string]interface{}{
+			{"op": "add", "path": "/metadata/annotations", "value": annotations},
+		})
+	}
+
+	var ops []map[string]interface{}
+	for _, key := range []string{linksAnnotation, groupAnnotation} {
+		value, found := current[key]
+		if found && value == annotations[key] {
+			continue
+		}
+		op := "add"
+		if found {
+			op = "replace"
+		}
+		ops = append(ops, map[string]interface{}{
+			"op":    op,
+			"path":  "/metadata/annotations/" + strings.ReplaceAll(strings.ReplaceAll(key, "~", "~0"), "/", "~1"),
+			"value": annotations[key],
+		})
+	}
+	if len(ops) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(ops)
+}
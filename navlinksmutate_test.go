@@ -0,0 +1,77 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "k8s.io/api/admission/v1"
+)
+
+func TestMutate(t *testing.T) {
+	links := "monitoring-team-prometheus-operated,monitoring-team-project-monitoring-grafana"
+
+	tests := []struct {
+		name        string
+		operation   v1.Operation
+		annotations map[string]string
+		message     string
+		patch       string
+	}{
+		{
+			name:      "create without annotations",
+			operation: v1.Create,
+			message:   "Navlinks annotated",
+			patch:     `[{"op":"add","path":"/metadata/annotations","value":{"navlinks.cattle.io/group":"monitoring-team","navlinks.cattle.io/links":"` + links + `"}}]`,
+		},
+		{
+			name:        "update with other annotations",
+			operation:   v1.Update,
+			annotations: map[string]string{"team": "a", groupAnnotation: "old"},
+			message:     "Navlinks annotated",
+			patch:       `[{"op":"add","path":"/metadata/annotations/navlinks.cattle.io~1links","value":"` + links + `"},{"op":"replace","path":"/metadata/annotations/navlinks.cattle.io~1group","value":"monitoring-team"}]`,
+		},
+		{
+			name:        "update unchanged",
+			operation:   v1.Update,
+			annotations: map[string]string{linksAnnotation: links, groupAnnotation: "monitoring-team"},
+			message:     "Navlinks annotation unchanged",
+		},
+		{
+			name:      "delete",
+			operation: v1.Delete,
+			message:   "Navlinks annotation skipped for operation DELETE",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nls := NewNavlinksServerHandler(NewSimpleFakeUiV1().NavLinks(), newFakeSources(), testBuilder(t, defaultConfig()), nil)
+			prom := testPrometheus("team", "prometheus")
+			prom.Annotations = tt.annotations
+
+			body := testAdmissionReview(t, tt.operation, false, prom)
+			rec := httptest.NewRecorder()
+			nls.mutate(rec, httptest.NewRequest(http.MethodPost, "/mutate", bytes.NewReader(body)))
+
+			resp := v1.AdmissionReview{}
+			if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("decoding response %q: %v", rec.Body.String(), err)
+			}
+			if !resp.Response.Allowed {
+				t.Errorf("allowed = false, want true")
+			}
+			if resp.Response.Result.Message != tt.message {
+				t.Errorf("message = %q, want %q", resp.Response.Result.Message, tt.message)
+			}
+			if string(resp.Response.Patch) != tt.patch {
+				t.Errorf("patch = %s, want %s", resp.Response.Patch, tt.patch)
+			}
+			if tt.patch != "" && (resp.Response.PatchType == nil || *resp.Response.PatchType != v1.PatchTypeJSONPatch) {
+				t.Errorf("patch type = %v, want %v", resp.Response.PatchType, v1.PatchTypeJSONPatch)
+			}
+		})
+	}
+}
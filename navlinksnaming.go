@@ -0,0 +1,182 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/golang/glog"
+
+	uiv1 "github.com/rancher/rancher/pkg/apis/ui.cattle.io/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+// default naming templates, the names of earlier versions
+const (
+	defaultNameTemplate  = "monitoring-{{ .Namespace }}-{{ .Service }}"
+	defaultGroupTemplate = "monitoring-{{ .Namespace }}"
+	defaultLabelTemplate = "{{ .Link.Label }}"
+	// defaultServiceNameTemplate keeps Service navlinks apart from the links to a service of the same name
+	defaultServiceNameTemplate = "monitoring-{{ .Namespace }}-service-{{ .Service }}"
+)
+
+// namingFuncs are the functions available in the naming templates
+var namingFuncs = template.FuncMap{
+	"lower":      strings.ToLower,
+	"upper":      strings.ToUpper,
+	"replace":    strings.ReplaceAll,
+	"trimPrefix": strings.TrimPrefix,
+	"trimSuffix": strings.TrimSuffix,
+	"default": func(def string, value string) string {
+		if value == "" {
+			return def
+		}
+		return value
+	},
+}
+
+// namingData is the data of the naming templates
+type namingData struct {
+	// Kind of the source object
+	Kind string
+	// Namespace of the source object
+	Namespace string
+	// Name of the source object
+	Name string
+	// Labels of the source object
+	Labels map[string]string
+	// Annotations of the source object
+	Annotations map[string]string
+	// Service is the target service of the link
+	Service string
+	// Link is the link config
+	Link LinkConfig
+
+	ctx             context.Context
+	namespaces      corev1client.NamespacesGetter
+	namespaceLabels map[string]string
+}
+
+// newNamingData returns the naming data for the link of the source object
+func newNamingData(ctx context.Context, namespaces corev1client.NamespacesGetter, source metav1.Object, link LinkConfig) *namingData {
+	return &namingData{
+		Kind:        sourceKind(source),
+		Namespace:   source.GetNamespace(),
+		Name:        source.GetName(),
+		Labels:      source.GetLabels(),
+		Annotations: source.GetAnnotations(),
+		Service:     link.Service,
+		Link:        link,
+		ctx:         ctx,
+		namespaces:  namespaces,
+	}
+}
+
+// NamespaceLabels returns the labels of the namespace, read on first use. When reading the
+// namespace fails the names are rendered without labels.
+func (d *namingData) NamespaceLabels() map[string]string {
+	if d.namespaceLabels != nil || d.namespaces == nil {
+		return d.namespaceLabels
+	}
+	d.namespaceLabels = map[string]string{}
+	ns, err := d.namespaces.Namespaces().Get(d.ctx, d.Namespace, metav1.GetOptions{})
+	if err != nil {
+		glog.Errorf("error getting namespace %s, naming %s/%s without namespace labels: %v", d.Namespace, d.Namespace, d.Name, err)
+		return d.namespaceLabels
+	}
+	if ns.Labels != nil {
+		d.namespaceLabels = ns.Labels
+	}
+	return d.namespaceLabels
+}
+
+// naming renders name, group, label and side label of the navlinks
+type naming struct {
+	name *template.Template
+	// serviceName is the name of Service navlinks, the name template when it is configured
+	serviceName *template.Template
+	group       *template.Template
+	label       *template.Template
+	sideLabel   *template.Template
+}
+
+// parseNaming parses the naming templates of the config, empty templates are the defaults
+func parseNaming(c NamingConfig) (*naming, error) {
+	parse := func(name string, text string, def string) (*template.Template, error) {
+		if text == "" {
+			text = def
+		}
+		t, err := template.New(name).Option("missingkey=zero").Funcs(namingFuncs).Parse(text)
+		if err != nil {
+			return nil, fmt.Errorf("naming %s: %w", name, err)
+		}
+		return t, nil
+	}
+
+	n := &naming{}
+	var err error
+	if n.name, err = parse("name", c.Name, defaultNameTemplate); err != nil {
+		return nil, err
+	}
+	n.serviceName = n.name
+	if c.Name == "" {
+		if n.serviceName, err = parse("name", defaultServiceNameTemplate, ""); err != nil {
+			return nil, err
+		}
+	}
+	if n.group, err = parse("group", c.Group, defaultGroupTemplate); err != nil {
+		return nil, err
+	}
+	if n.label, err = parse("label", c.Label, defaultLabelTemplate); err != nil {
+		return nil, err
+	}
+	if n.sideLabel, err = parse("sideLabel", c.SideLabel, ""); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+// apply renders the templates with the data into the navlink
+func (n *naming) apply(nl *uiv1.NavLink, data *namingData) error {
+	nameTemplate := n.name
+	if data.Kind == serviceKind {
+		nameTemplate = n.serviceName
+	}
+	name, err := execute(nameTemplate, data)
+	if err != nil {
+		return err
+	}
+	if errs := validation.IsDNS1123Subdomain(name); len(errs) > 0 {
+		return fmt.Errorf("naming name %q: %s", name, strings.Join(errs, ", "))
+	}
+	group, err := execute(n.group, data)
+	if err != nil {
+		return err
+	}
+	label, err := execute(n.label, data)
+	if err != nil {
+		return err
+	}
+	sideLabel, err := execute(n.sideLabel, data)
+	if err != nil {
+		return err
+	}
+
+	nl.Name = name
+	nl.Spec.Group = group
+	nl.Spec.Label = label
+	nl.Spec.SideLabel = sideLabel
+	return nil
+}
+
+func execute(t *template.Template, data *namingData) (string, error) {
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("naming %s: %w", t.Name(), err)
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNaming(t *testing.T) {
+	config := defaultConfig()
+	config.Naming = NamingConfig{
+		Name:      `{{ .Namespace }}-{{ .Link.Name }}`,
+		Group:     `{{ index .NamespaceLabels "team" | default .Namespace | upper }}`,
+		Label:     `{{ .Link.Name }} ({{ .Name }})`,
+		SideLabel: `{{ .Labels.env }}`,
+	}
+	team := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team", Labels: map[string]string{"team": "platform"}}}
+	builder := testBuilder(t, config, team)
+
+	prom := testPrometheus("team", "main")
+	prom.Labels = map[string]string{"env": "prod"}
+	navlinks, err := builder.prometheusNavlinks(context.Background(), prom)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nl := navlinks[0]
+	if nl.Name != "team-prometheus" || nl.Spec.Group != "PLATFORM" || nl.Spec.Label != "prometheus (main)" || nl.Spec.SideLabel != "prod" {
+		t.Errorf("navlink = %s group %q label %q sideLabel %q", nl.Name, nl.Spec.Group, nl.Spec.Label, nl.Spec.SideLabel)
+	}
+
+	// a namespace failing to read is rendered without labels
+	other := testPrometheus("other", "main")
+	navlinks, err = builder.prometheusNavlinks(context.Background(), other)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nl := navlinks[0]; nl.Name != "other-prometheus" || nl.Spec.Group != "OTHER" {
+		t.Errorf("navlink = %s group %q, want other-prometheus group OTHER", nl.Name, nl.Spec.Group)
+	}
+}
+
+func TestNamingInvalid(t *testing.T) {
+	tests := map[string]NamingConfig{
+		"parse":     {Name: "{{ .Namespace"},
+		"field":     {Group: "{{ .Missing }}"},
+		"name":      {Name: "{{ .Namespace }}_{{ .Service }}"},
+		"duplicate": {Name: "{{ .Namespace }}"},
+	}
+	for name, naming := range tests {
+		t.Run(name, func(t *testing.T) {
+			config := defaultConfig()
+			config.Naming = naming
+			if _, err := newNavlinkBuilder(config, nil); err == nil {
+				t.Error("want error")
+			}
+		})
+	}
+}
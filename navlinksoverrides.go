@@ -0,0 +1,74 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// annotations on Prometheus objects overriding the navlinks, <link> is the name of the link config
+const (
+	// skipAnnotation set to true creates no navlinks for the Prometheus
+	skipAnnotation = "navlinks.cattle.io/skip"
+	// navlinks.cattle.io/<link>-skip set to true creates not the navlink of the link
+	linkSkipSuffix = "-skip"
+	// navlinks.cattle.io/<link>-service is the service of the link in the namespace of the Prometheus
+	linkServiceSuffix = "-service"
+	// navlinks.cattle.io/<link>-port is the port number or name of the service
+	linkPortSuffix = "-port"
+	// navlinks.cattle.io/<link>-label is shown in the Rancher UI
+	linkLabelSuffix = "-label"
+
+	overridePrefix = "navlinks.cattle.io/"
+)
+
+// skipNavlinks reports whether the annotations skip all navlinks
+func skipNavlinks(annotations map[string]string) (bool, error) {
+	return annotationBool(annotations, skipAnnotation)
+}
+
+// overrideLink returns the link with the overrides of the annotations, skip reports that the
+// navlink of the link is not created. Services are names in the namespace of the Prometheus,
+// references to other namespaces are rejected.
+func overrideLink(annotations map[string]string, link LinkConfig) (LinkConfig, bool, error) {
+	prefix := overridePrefix + link.Name
+	skip, err := annotationBool(annotations, prefix+linkSkipSuffix)
+	if err != nil || skip {
+		return link, skip, err
+	}
+
+	if service, ok := annotations[prefix+linkServiceSuffix]; ok {
+		if strings.ContainsAny(service, "./") {
+			return link, false, fmt.Errorf("annotation %s: service %q must be in the namespace of the Prometheus", prefix+linkServiceSuffix, service)
+		}
+		if errs := validation.IsDNS1035Label(service); len(errs) > 0 {
+			return link, false, fmt.Errorf("annotation %s: service %q: %s", prefix+linkServiceSuffix, service, strings.Join(errs, ", "))
+		}
+		link.Service = service
+	}
+	if port, ok := annotations[prefix+linkPortSuffix]; ok {
+		if err := validatePort(port); err != nil {
+			return link, false, fmt.Errorf("annotation %s: %w", prefix+linkPortSuffix, err)
+		}
+		link.Port = port
+	}
+	if label, ok := annotations[prefix+linkLabelSuffix]; ok {
+		link.Label = label
+	}
+	return link, false, nil
+}
+
+// annotationBool parses the boolean annotation, false when not set
+func annotationBool(annotations map[string]string, key string) (bool, error) {
+	value, ok := annotations[key]
+	if !ok {
+		return false, nil
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("annotation %s: %q is not a boolean", key, value)
+	}
+	return b, nil
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestOverrides(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        []string
+		wantErr     bool
+	}{
+		{
+			name: "none",
+			want: []string{
+				"monitoring-team-prometheus-operated",
+				"monitoring-team-project-monitoring-grafana",
+			},
+		},
+		{
+			name:        "skip",
+			annotations: map[string]string{"navlinks.cattle.io/skip": "true"},
+			want:        []string{},
+		},
+		{
+			name:        "skip grafana",
+			annotations: map[string]string{"navlinks.cattle.io/grafana-skip": "true"},
+			want:        []string{"monitoring-team-prometheus-operated"},
+		},
+		{
+			name: "grafana service",
+			annotations: map[string]string{
+				"navlinks.cattle.io/prometheus-skip": "true",
+				"navlinks.cattle.io/grafana-service": "grafana",
+				"navlinks.cattle.io/grafana-port":    "3000",
+				"navlinks.cattle.io/grafana-label":   "Dashboards",
+			},
+			want: []string{"monitoring-team-grafana"},
+		},
+		{
+			name:        "service in other namespace",
+			annotations: map[string]string{"navlinks.cattle.io/grafana-service": "grafana.other"},
+			wantErr:     true,
+		},
+		{
+			name:        "invalid port",
+			annotations: map[string]string{"navlinks.cattle.io/grafana-port": "70000"},
+			wantErr:     true,
+		},
+		{
+			name:        "invalid skip",
+			annotations: map[string]string{"navlinks.cattle.io/skip": "yes please"},
+			wantErr:     true,
+		},
+	}
+
+	builder := testBuilder(t, defaultConfig())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prom := testPrometheus("team", "prometheus")
+			prom.Annotations = tt.annotations
+
+			navlinks, err := builder.prometheusNavlinks(context.Background(), prom)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("navlinks = %v, want error", navlinkList(navlinks))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			names := []string{}
+			for _, nl := range navlinks {
+				names = append(names, nl.Name)
+			}
+			if !reflect.DeepEqual(names, tt.want) {
+				t.Fatalf("navlinks = %v, want %v", names, tt.want)
+			}
+			if tt.annotations["navlinks.cattle.io/grafana-port"] == "3000" {
+				grafana := navlinks[0]
+				if grafana.Spec.ToService.Port.String() != "3000" || grafana.Spec.Label != "Dashboards" || grafana.Spec.ToService.Namespace != "team" {
+					t.Errorf("grafana = %+v, label %q", grafana.Spec.ToService, grafana.Spec.Label)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,207 @@
+package main
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/golang/glog"
+
+	uiv1 "github.com/rancher/rancher/pkg/apis/ui.cattle.io/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	networkingv1client "k8s.io/client-go/kubernetes/typed/networking/v1"
+)
+
+// access of the navlinks
+const (
+	// accessProxy links the service through the Rancher API proxy
+	accessProxy = "proxy"
+	// accessDirect links the public URL of an Ingress or HTTPRoute to the service
+	accessDirect = "direct"
+)
+
+// routeClients are the clients of the Ingresses and HTTPRoutes exposing services
+type routeClients struct {
+	ingresses networkingv1client.IngressesGetter
+	gateway   GatewayV1Interface
+}
+
+// directURL replaces the service of the navlink with the public URL of the first Ingress or
+// HTTPRoute to the service, the navlink is kept as it is without one or when the lookup fails
+func (r *routeClients) directURL(ctx context.Context, nl *uiv1.NavLink) {
+	svc := nl.Spec.ToService
+	if r == nil || svc == nil {
+		return
+	}
+	u, err := r.routeURL(ctx, svc.Namespace, svc.Name, svc.Port.String(), svc.Path)
+	if err != nil {
+		glog.Errorf("error looking up routes to service %s/%s, navlink %s links the service: %v", svc.Namespace, svc.Name, nl.Name, err)
+		return
+	}
+	if u != "" {
+		nl.Spec.ToService = nil
+		nl.Spec.ToURL = u
+	}
+}
+
+// routeURL returns the public URL of the first Ingress or HTTPRoute to the service, empty without one
+func (r *routeClients) routeURL(ctx context.Context, namespace string, service string, port string, path string) (string, error) {
+	ingresses, err := r.ingresses.Ingresses(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return "", err
+	}
+	if u := ingressURL(ingresses.Items, service, port, path); u != "" {
+		return u, nil
+	}
+	return r.httpRouteURL(ctx, namespace, service, port, path)
+}
+
+// ingressURL returns the URL of the first Ingress rule with a host to the service, https when
+// the host is in the TLS of the Ingress
+func ingressURL(ingresses []networkingv1.Ingress, service string, port string, path string) string {
+	for _, ing := range ingresses {
+		for _, rule := range ing.Spec.Rules {
+			if rule.Host == "" || strings.HasPrefix(rule.Host, "*") || rule.HTTP == nil {
+				continue
+			}
+			for _, p := range rule.HTTP.Paths {
+				backend := p.Backend.Service
+				if backend == nil || backend.Name != service || !portMatches(port, backend.Port.Number, backend.Port.Name) {
+					continue
+				}
+				scheme := "http"
+				if ingressTLS(&ing, rule.Host) {
+					scheme = "https"
+				}
+				return scheme + "://" + rule.Host + joinPath(p.Path, path)
+			}
+		}
+	}
+	return ""
+}
+
+// ingressTLS reports whether the Ingress terminates TLS for the host
+func ingressTLS(ing *networkingv1.Ingress, host string) bool {
+	for _, tls := range ing.Spec.TLS {
+		if len(tls.Hosts) == 0 {
+			return true
+		}
+		for _, h := range tls.Hosts {
+			if h == host {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// httpRouteURL returns the URL of the first HTTPRoute rule to the service, with the scheme of
+// the listener of its Gateway. Without the Gateway API installed there is none.
+func (r *routeClients) httpRouteURL(ctx context.Context, namespace string, service string, port string, path string) (string, error) {
+	routes, err := r.gateway.HTTPRoutes(namespace).List(ctx, metav1.ListOptions{})
+	if k8serrors.IsNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	for _, route := range routes.Items {
+		for _, rule := range route.Spec.Rules {
+			if !routesTo(rule.BackendRefs, namespace, service, port) {
+				continue
+			}
+			prefix := "/"
+			if len(rule.Matches) > 0 && rule.Matches[0].Path != nil && rule.Matches[0].Path.Type != "RegularExpression" {
+				prefix = rule.Matches[0].Path.Value
+			}
+			host, scheme, err := r.routeHost(ctx, &route)
+			if err != nil {
+				return "", err
+			}
+			if host != "" {
+				return scheme + "://" + host + joinPath(prefix, path), nil
+			}
+		}
+	}
+	return "", nil
+}
+
+// routesTo reports whether one of the backends is the service
+func routesTo(backends []HTTPBackendRef, namespace string, service string, port string) bool {
+	for _, backend := range backends {
+		if backend.Group != "" || (backend.Kind != "" && backend.Kind != "Service") {
+			continue
+		}
+		if backend.Namespace != "" && backend.Namespace != namespace {
+			continue
+		}
+		if backend.Name == service && portMatches(port, backend.Port, "") {
+			return true
+		}
+	}
+	return false
+}
+
+// routeHost returns the first hostname of the HTTPRoute, or of the listener of its Gateway,
+// and the scheme of the listener. It is https when the Gateway is not readable.
+func (r *routeClients) routeHost(ctx context.Context, route *HTTPRoute) (string, string, error) {
+	host := publicHost(route.Spec.Hostnames...)
+	for _, parent := range route.Spec.ParentRefs {
+		if parent.Kind != "" && parent.Kind != "Gateway" {
+			continue
+		}
+		ns := parent.Namespace
+		if ns == "" {
+			ns = route.Namespace
+		}
+		gw, err := r.gateway.Gateways(ns).Get(ctx, parent.Name, metav1.GetOptions{})
+		if k8serrors.IsNotFound(err) || k8serrors.IsForbidden(err) {
+			continue
+		}
+		if err != nil {
+			return "", "", err
+		}
+		for _, listener := range gw.Spec.Listeners {
+			if parent.SectionName != "" && parent.SectionName != listener.Name {
+				continue
+			}
+			if listener.Protocol != "HTTP" && listener.Protocol != "HTTPS" {
+				continue
+			}
+			if h := publicHost(host, listener.Hostname); h != "" {
+				return h, strings.ToLower(listener.Protocol), nil
+			}
+		}
+	}
+	return host, "https", nil
+}
+
+// publicHost returns the first host without wildcard
+func publicHost(hosts ...string) string {
+	for _, h := range hosts {
+		if h != "" && !strings.HasPrefix(h, "*") {
+			return h
+		}
+	}
+	return ""
+}
+
+// portMatches reports whether the port number or name of the link is the port of a route
+// backend, a port number can't be compared with a name and matches
+func portMatches(port string, number int32, name string) bool {
+	if n, err := strconv.Atoi(port); err == nil {
+		return number == 0 || int32(n) == number
+	}
+	return name == "" || name == port
+}
+
+// joinPath appends the path of the link to the path of the route
+func joinPath(prefix string, path string) string {
+	joined := strings.TrimSuffix(prefix, "/") + path
+	if joined == "" {
+		return "/"
+	}
+	return joined
+}
@@ -0,0 +1,154 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func testIngress(host string, path string, tls bool) *networkingv1.Ingress {
+	ing := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "team", Name: "grafana"},
+		Spec: networkingv1.IngressSpec{
+			Rules: []networkingv1.IngressRule{{
+				Host: host,
+				IngressRuleValue: networkingv1.IngressRuleValue{HTTP: &networkingv1.HTTPIngressRuleValue{
+					Paths: []networkingv1.HTTPIngressPath{{
+						Path: path,
+						Backend: networkingv1.IngressBackend{Service: &networkingv1.IngressServiceBackend{
+							Name: "project-monitoring-grafana",
+							Port: networkingv1.ServiceBackendPort{Number: 80},
+						}},
+					}},
+				}},
+			}},
+		},
+	}
+	if tls {
+		ing.Spec.TLS = []networkingv1.IngressTLS{{Hosts: []string{host}}}
+	}
+	return ing
+}
+
+func testHTTPRoute(hostnames ...string) *HTTPRoute {
+	return &HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "team", Name: "grafana"},
+		Spec: HTTPRouteSpec{
+			ParentRefs: []ParentReference{{Name: "public", Namespace: "gateway", SectionName: "https"}},
+			Hostnames:  hostnames,
+			Rules: []HTTPRouteRule{{
+				Matches:     []HTTPRouteMatch{{Path: &HTTPPathMatch{Type: "PathPrefix", Value: "/grafana"}}},
+				BackendRefs: []HTTPBackendRef{{Name: "project-monitoring-grafana", Port: 80}},
+			}},
+		},
+	}
+}
+
+func TestDirectURL(t *testing.T) {
+	gateway := &Gateway{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "gateway", Name: "public"},
+		Spec: GatewaySpec{Listeners: []Listener{
+			{Name: "http", Port: 80, Protocol: "HTTP"},
+			{Name: "https", Hostname: "*.example.com", Port: 443, Protocol: "HTTPS"},
+		}},
+	}
+
+	tests := []struct {
+		name      string
+		access    string
+		ingresses []runtime.Object
+		routes    []runtime.Object
+		failing   string
+		toURL     string
+	}{
+		{
+			name:      "ingress with tls",
+			access:    accessDirect,
+			ingresses: []runtime.Object{testIngress("grafana.example.com", "/", true)},
+			toURL:     "https://grafana.example.com/",
+		},
+		{
+			name:      "ingress path",
+			access:    accessDirect,
+			ingresses: []runtime.Object{testIngress("team.example.com", "/grafana", false)},
+			toURL:     "http://team.example.com/grafana",
+		},
+		{
+			name:      "ingress without host",
+			access:    accessDirect,
+			ingresses: []runtime.Object{testIngress("", "/", false)},
+		},
+		{
+			name:   "httproute",
+			access: accessDirect,
+			routes: []runtime.Object{testHTTPRoute("grafana.example.com"), gateway},
+			toURL:  "https://grafana.example.com/grafana",
+		},
+		{
+			name:   "httproute with wildcard hostname",
+			access: accessDirect,
+			routes: []runtime.Object{testHTTPRoute("*.example.com"), gateway},
+		},
+		{
+			name:      "listing ingresses failed",
+			access:    accessDirect,
+			ingresses: []runtime.Object{testIngress("grafana.example.com", "/", true)},
+			failing:   "ingresses",
+		},
+		{
+			name:    "listing httproutes failed",
+			access:  accessDirect,
+			routes:  []runtime.Object{testHTTPRoute("grafana.example.com"), gateway},
+			failing: "httproutes",
+		},
+		{
+			name:   "no route",
+			access: accessDirect,
+		},
+		{
+			name:      "proxy",
+			access:    accessProxy,
+			ingresses: []runtime.Object{testIngress("grafana.example.com", "/", true)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := defaultConfig()
+			config.Access = tt.access
+			builder := testBuilder(t, config)
+			ingresses := kubefake.NewSimpleClientset(tt.ingresses...)
+			gateway := NewSimpleFakeGatewayV1(tt.routes...)
+			if tt.failing != "" {
+				fail := func(k8stesting.Action) (bool, runtime.Object, error) {
+					return true, nil, k8serrors.NewInternalError(errors.New("apiserver unavailable"))
+				}
+				ingresses.PrependReactor("list", tt.failing, fail)
+				gateway.PrependReactor("list", tt.failing, fail)
+			}
+			builder.routes = &routeClients{ingresses: ingresses.NetworkingV1(), gateway: gateway}
+
+			navlinks, err := builder.prometheusNavlinks(context.Background(), testPrometheus("team", "prometheus"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			grafana := navlinks[1]
+			if grafana.Spec.ToURL != tt.toURL {
+				t.Errorf("toURL = %q, want %q", grafana.Spec.ToURL, tt.toURL)
+			}
+			if (grafana.Spec.ToService == nil) != (tt.toURL != "") {
+				t.Errorf("toService = %+v with toURL %q", grafana.Spec.ToService, grafana.Spec.ToURL)
+			}
+			if navlinks[0].Spec.ToService == nil {
+				t.Errorf("prometheus navlink without route has no toService")
+			}
+		})
+	}
+}
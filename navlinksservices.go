@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	uiv1 "github.com/rancher/rancher/pkg/apis/ui.cattle.io/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// serviceKind is the kind of the Services labeled for navlinks
+const serviceKind = "Service"
+
+// label and annotations of Services with their own navlink
+const (
+	// serviceEnabledLabel set to true creates a navlink to the Service
+	serviceEnabledLabel = "navlinks.cattle.io/enabled"
+	// serviceLabelAnnotation is shown in the Rancher UI, default the Service name
+	serviceLabelAnnotation = "navlinks.cattle.io/label"
+	// servicePortAnnotation is the port number or name, default the first port of the Service
+	servicePortAnnotation = "navlinks.cattle.io/port"
+	// servicePathAnnotation is appended to the service url
+	servicePathAnnotation = "navlinks.cattle.io/path"
+	// serviceIconAnnotation is a builtin icon, a data URI or an URL
+	serviceIconAnnotation = "navlinks.cattle.io/icon"
+)
+
+// serviceSelector selects the Services labeled for navlinks
+func serviceSelector() labels.Selector {
+	return labels.SelectorFromSet(labels.Set{serviceEnabledLabel: "true"})
+}
+
+// serviceLink returns the link to a labeled Service from its annotations
+func (c *Config) serviceLink(svc *corev1.Service) (LinkConfig, error) {
+	port, ok := svc.Annotations[servicePortAnnotation]
+	if ok {
+		if err := validatePort(port); err != nil {
+			return LinkConfig{}, fmt.Errorf("annotation %s: %w", servicePortAnnotation, err)
+		}
+	} else {
+		if len(svc.Spec.Ports) == 0 {
+			return LinkConfig{}, fmt.Errorf("service %s has no ports", svc.Name)
+		}
+		port = svc.Spec.Ports[0].Name
+		if port == "" {
+			port = strconv.Itoa(int(svc.Spec.Ports[0].Port))
+		}
+	}
+	path := svc.Annotations[servicePathAnnotation]
+	if path != "" && !strings.HasPrefix(path, "/") {
+		return LinkConfig{}, fmt.Errorf("annotation %s: path %q must start with /", servicePathAnnotation, path)
+	}
+	icon, ok := svc.Annotations[serviceIconAnnotation]
+	if !ok {
+		icon = c.Services.Icon
+	}
+	label, ok := svc.Annotations[serviceLabelAnnotation]
+	if !ok {
+		label = svc.Name
+	}
+	return LinkConfig{
+		Name:    svc.Name,
+		Service: svc.Name,
+		Port:    port,
+		Path:    path,
+		Icon:    icon,
+		Label:   label,
+		Access:  c.Services.Access,
+		Scheme:  c.Services.Scheme,
+	}, nil
+}
+
+// serviceNavlinks returns the navlink of a Service labeled with navlinks.cattle.io/enabled=true,
+// none for other Services
+func (b *navlinkBuilder) serviceNavlinks(ctx context.Context, svc *corev1.Service) ([]uiv1.NavLink, error) {
+	if b.config.Services.Disabled || !serviceSelector().Matches(labels.Set(svc.Labels)) {
+		return nil, nil
+	}
+	link, err := b.config.serviceLink(svc)
+	if err != nil {
+		return nil, err
+	}
+	nl, err := b.sourceNavlink(ctx, svc, corev1.SchemeGroupVersion.String(), serviceKind, link)
+	if err != nil {
+		return nil, err
+	}
+	return []uiv1.NavLink{nl}, nil
+}
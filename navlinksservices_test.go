@@ -0,0 +1,108 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	v1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func testService(name string, labels map[string]string, annotations map[string]string) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "team", Name: name, UID: "service-uid", Labels: labels, Annotations: annotations},
+		Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Port: 8080}}},
+	}
+}
+
+func TestServeService(t *testing.T) {
+	enabled := map[string]string{serviceEnabledLabel: "true"}
+	docs := testService("docs", enabled, map[string]string{
+		serviceLabelAnnotation: "Docs",
+		servicePortAnnotation:  "http",
+		servicePathAnnotation:  "/ui",
+		serviceIconAnnotation:  "grafana",
+	})
+	navlinks := NewSimpleFakeUiV1()
+	nls := NewNavlinksServerHandler(navlinks.NavLinks(), newFakeSources(), testBuilder(t, defaultConfig()), nil)
+	serve := func(op v1.Operation, svc *corev1.Service) v1.AdmissionReview {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodPost, "/validate", bytes.NewReader(testAdmissionReview(t, op, false, svc)))
+		rec := httptest.NewRecorder()
+		nls.serve(rec, req)
+		drainWrites(t, nls)
+		resp := v1.AdmissionReview{}
+		if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+			t.Fatal(err)
+		}
+		return resp
+	}
+
+	serve(v1.Create, docs)
+	serve(v1.Create, testService("api", enabled, nil))
+	serve(v1.Create, testService("internal", nil, nil))
+	want := []string{"monitoring-team-service-api", "monitoring-team-service-docs"}
+	if names := navlinkNames(t, navlinks); !reflect.DeepEqual(names, want) {
+		t.Fatalf("navlinks = %v, want %v", names, want)
+	}
+
+	nl, err := navlinks.NavLinks().Get(context.Background(), "monitoring-team-service-docs", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nl.Spec.Label != "Docs" || nl.Spec.ToService.Port.String() != "http" || nl.Spec.ToService.Path != "/ui" || nl.Spec.IconSrc != logoGrafana {
+		t.Errorf("navlink = %+v, %+v", nl.Spec, nl.Spec.ToService)
+	}
+	api, err := navlinks.NavLinks().Get(context.Background(), "monitoring-team-service-api", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if api.Spec.Label != "api" || api.Spec.ToService.Port.String() != "8080" || api.Labels[sourceKindLabel] != serviceKind {
+		t.Errorf("navlink = %+v, labels %v", api.Spec, api.Labels)
+	}
+
+	if resp := serve(v1.Create, testService("bad", enabled, map[string]string{servicePathAnnotation: "ui"})); resp.Response.Allowed {
+		t.Errorf("service with invalid path allowed")
+	}
+
+	// each Service has its own navlink, deleting one keeps the others
+	serve(v1.Delete, docs)
+	if names := navlinkNames(t, navlinks); !reflect.DeepEqual(names, []string{"monitoring-team-service-api"}) {
+		t.Errorf("navlinks = %v after delete", names)
+	}
+}
+
+func TestDesiredNavlinksServices(t *testing.T) {
+	enabled := map[string]string{serviceEnabledLabel: "true"}
+	sources := []metav1.Object{
+		testPrometheus("team", "prometheus"),
+		testService("docs", enabled, nil),
+		testService("api", enabled, nil),
+		// same service as the prometheus link, the navlink names must not collide
+		testService("prometheus-operated", enabled, nil),
+	}
+	desired, failed := testBuilder(t, defaultConfig()).desiredNavlinks(context.Background(), sources)
+	if len(failed) > 0 {
+		t.Fatal(failed)
+	}
+	names := []string{}
+	for _, nl := range desired {
+		names = append(names, nl.Name)
+	}
+	want := []string{
+		"monitoring-team-prometheus-operated",
+		"monitoring-team-project-monitoring-grafana",
+		"monitoring-team-service-api",
+		"monitoring-team-service-docs",
+		"monitoring-team-service-prometheus-operated",
+	}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("navlinks = %v, want %v", names, want)
+	}
+}
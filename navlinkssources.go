@@ -0,0 +1,164 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/glog"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+// sourceKinds are the kinds of objects navlinks are created for
+var sourceKinds = []string{
+	monitoringv1.PrometheusesKind,
+	monitoringv1.AlertmanagersKind,
+	monitoringv1.ThanosRulerKind,
+	grafanaKind,
+	serviceKind,
+}
+
+// sourceClients are the clients of the source objects
+type sourceClients struct {
+	monitoring MonitoringV1Interface
+	grafana    GrafanaV1beta1Interface
+	services   corev1client.ServicesGetter
+}
+
+// sourceKind returns the kind of the source object, empty for unsupported objects
+func sourceKind(obj metav1.Object) string {
+	switch obj.(type) {
+	case *monitoringv1.Prometheus:
+		return monitoringv1.PrometheusesKind
+	case *monitoringv1.Alertmanager:
+		return monitoringv1.AlertmanagersKind
+	case *monitoringv1.ThanosRuler:
+		return monitoringv1.ThanosRulerKind
+	case *Grafana:
+		return grafanaKind
+	case *corev1.Service:
+		return serviceKind
+	}
+	return ""
+}
+
+// perObjectKind reports whether each object of the kind has its own navlinks, instead of
+// the first object of the kind in the namespace. Grafanas link their own <name>-service.
+func perObjectKind(kind string) bool {
+	return kind == grafanaKind || kind == serviceKind
+}
+
+// sourceKey identifies the objects whose navlinks are built together
+func sourceKey(obj metav1.Object) string {
+	key := obj.GetNamespace() + "/" + sourceKind(obj)
+	if perObjectKind(sourceKind(obj)) {
+		key += "/" + obj.GetName()
+	}
+	return key
+}
+
+// newSource returns an empty source object of the kind, nil for unsupported kinds
+func newSource(kind string) metav1.Object {
+	switch kind {
+	case monitoringv1.PrometheusesKind:
+		return &monitoringv1.Prometheus{}
+	case monitoringv1.AlertmanagersKind:
+		return &monitoringv1.Alertmanager{}
+	case monitoringv1.ThanosRulerKind:
+		return &monitoringv1.ThanosRuler{}
+	case grafanaKind:
+		return &Grafana{}
+	case serviceKind:
+		return &corev1.Service{}
+	}
+	return nil
+}
+
+// metaOf returns the object meta of the source object
+func metaOf(obj metav1.Object) *metav1.ObjectMeta {
+	switch source := obj.(type) {
+	case *monitoringv1.Prometheus:
+		return &source.ObjectMeta
+	case *monitoringv1.Alertmanager:
+		return &source.ObjectMeta
+	case *monitoringv1.ThanosRuler:
+		return &source.ObjectMeta
+	case *Grafana:
+		return &source.ObjectMeta
+	case *corev1.Service:
+		return &source.ObjectMeta
+	}
+	return nil
+}
+
+// listSources lists the source objects of the kind in the namespace, all namespaces when empty.
+// Services are only listed with the navlinks label.
+func (c *sourceClients) listSources(ctx context.Context, kind string, namespace string, opts metav1.ListOptions) ([]metav1.Object, error) {
+	var sources []metav1.Object
+	switch kind {
+	case monitoringv1.PrometheusesKind:
+		list, err := c.monitoring.Prometheuses(namespace).List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, prom := range list.Items {
+			sources = append(sources, prom)
+		}
+	case monitoringv1.AlertmanagersKind:
+		list, err := c.monitoring.Alertmanagers(namespace).List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		for i := range list.Items {
+			sources = append(sources, &list.Items[i])
+		}
+	case monitoringv1.ThanosRulerKind:
+		list, err := c.monitoring.ThanosRulers(namespace).List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, tr := range list.Items {
+			sources = append(sources, tr)
+		}
+	case grafanaKind:
+		list, err := c.grafana.Grafanas(namespace).List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		for i := range list.Items {
+			sources = append(sources, &list.Items[i])
+		}
+	case serviceKind:
+		opts.LabelSelector = serviceSelector().String()
+		list, err := c.services.Services(namespace).List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		for i := range list.Items {
+			sources = append(sources, &list.Items[i])
+		}
+	}
+	return sources, nil
+}
+
+// availableKinds returns the kinds whose resource is served by the API server, so a missing
+// CRD of an optional kind doesn't stop controller and backfill
+func (c *sourceClients) availableKinds(ctx context.Context, kinds []string) ([]string, error) {
+	available := []string{}
+	for _, kind := range kinds {
+		_, err := c.listSources(ctx, kind, metav1.NamespaceAll, metav1.ListOptions{Limit: 1})
+		if k8serrors.IsNotFound(err) && kind != monitoringv1.PrometheusesKind {
+			glog.Warningf("%s resource not available, no navlinks created for it: %v", kind, err)
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("listing %s: %w", kind, err)
+		}
+		available = append(available, kind)
+	}
+	return available, nil
+}
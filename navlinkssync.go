@@ -0,0 +1,146 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/golang/glog"
+
+	uiv1 "github.com/rancher/rancher/pkg/apis/ui.cattle.io/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+// syncNavlinks converges the current navlinks to the desired ones. Missing navlinks
+// are created, changed ones updated and navlinks not desired anymore deleted.
+func syncNavlinks(ctx context.Context, client NavLinkInterface, desired []uiv1.NavLink, current []uiv1.NavLink) error {
+	existing := make(map[string]*uiv1.NavLink, len(current))
+	for i := range current {
+		existing[current[i].Name] = &current[i]
+	}
+
+	var errs []error
+	for i := range desired {
+		nl := desired[i].DeepCopy()
+		old, found := existing[nl.Name]
+		delete(existing, nl.Name)
+		if !found {
+			if err := createNavlink(ctx, client, nl); err != nil {
+				errs = append(errs, err)
+			}
+			continue
+		}
+		if navlinkEqual(old, nl) {
+			continue
+		}
+		if err := updateNavlink(ctx, client, old, nl); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	for name := range existing {
+		err := client.Delete(ctx, name, metav1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("deleting navlink %s: %w", name, err))
+			continue
+		}
+		glog.Info("navlinks deleted: ", name)
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// createNavlink creates the navlink, an already existing navlink with the same name is taken over
+func createNavlink(ctx context.Context, client NavLinkInterface, nl *uiv1.NavLink) error {
+	_, err := client.Create(ctx, nl, metav1.CreateOptions{})
+	if err == nil {
+		glog.Info("navlinks created: ", nl.Name)
+		return nil
+	}
+	if !k8serrors.IsAlreadyExists(err) {
+		return fmt.Errorf("creating navlink %s: %w", nl.Name, err)
+	}
+	old, err := client.Get(ctx, nl.Name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("getting navlink %s: %w", nl.Name, err)
+	}
+	if navlinkEqual(old, nl) {
+		return nil
+	}
+	return updateNavlink(ctx, client, old, nl)
+}
+
+// updateNavlink replaces the old navlink with the desired one, the requester of the old navlink is kept
+func updateNavlink(ctx context.Context, client NavLinkInterface, old *uiv1.NavLink, nl *uiv1.NavLink) error {
+	nl.ResourceVersion = old.ResourceVersion
+	if requester, ok := old.Annotations[requestedByAnnotation]; ok && nl.Annotations[requestedByAnnotation] == "" {
+		if nl.Annotations == nil {
+			nl.Annotations = map[string]string{}
+		}
+		nl.Annotations[requestedByAnnotation] = requester
+	}
+	if _, err := client.Update(ctx, nl, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("updating navlink %s: %w", nl.Name, err)
+	}
+	glog.Info("navlinks updated: ", nl.Name)
+	return nil
+}
+
+// patchNavlink replaces labels, annotations and spec of the existing navlink with the ones of the desired navlink
+func patchNavlink(ctx context.Context, client NavLinkInterface, nl *uiv1.NavLink) error {
+	patch, err := json.Marshal([]map[string]interface{}{
+		{"op": "add", "path": "/metadata/labels", "value": nl.Labels},
+		{"op": "add", "path": "/metadata/annotations", "value": nl.Annotations},
+		{"op": "replace", "path": "/spec", "value": nl.Spec},
+	})
+	if err != nil {
+		return fmt.Errorf("encoding patch for navlink %s: %w", nl.Name, err)
+	}
+	if _, err := client.Patch(ctx, nl.Name, types.JSONPatchType, patch, metav1.PatchOptions{}); err != nil {
+		return fmt.Errorf("patching navlink %s: %w", nl.Name, err)
+	}
+	glog.Info("navlinks patched: ", nl.Name)
+	return nil
+}
+
+// diffNavlinks compares the navlinks desired before and after a change and returns
+// the navlinks to create, the changed navlinks and the navlinks to delete
+func diffNavlinks(before []uiv1.NavLink, after []uiv1.NavLink) (created []uiv1.NavLink, changed []uiv1.NavLink, deleted []uiv1.NavLink) {
+	old := make(map[string]*uiv1.NavLink, len(before))
+	for i := range before {
+		old[before[i].Name] = &before[i]
+	}
+	for i := range after {
+		nl := &after[i]
+		prev, found := old[nl.Name]
+		delete(old, nl.Name)
+		switch {
+		case !found:
+			created = append(created, *nl)
+		case !navlinkEqual(prev, nl):
+			changed = append(changed, *nl)
+		}
+	}
+	for i := range before {
+		if _, found := old[before[i].Name]; found {
+			deleted = append(deleted, before[i])
+		}
+	}
+	return created, changed, deleted
+}
+
+// navlinkEqual reports whether the navlinks have the same spec, labels, source and owners
+func navlinkEqual(a *uiv1.NavLink, b *uiv1.NavLink) bool {
+	for _, key := range sourceAnnotations {
+		if a.Annotations[key] != b.Annotations[key] {
+			return false
+		}
+	}
+	return equality.Semantic.DeepEqual(a.Spec, b.Spec) &&
+		equality.Semantic.DeepEqual(a.Labels, b.Labels) &&
+		equality.Semantic.DeepEqual(a.OwnerReferences, b.OwnerReferences)
+}
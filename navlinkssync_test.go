@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	uiv1 "github.com/rancher/rancher/pkg/apis/ui.cattle.io/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDiffNavlinks(t *testing.T) {
+	prometheus := specNavlinks("team", LinkConfig{Name: "prometheus", Service: "prometheus-operated", Port: "9090", Icon: "prometheus"})
+	grafana := specNavlinks("team", LinkConfig{Name: "grafana", Service: "project-monitoring-grafana", Port: "80", Icon: "grafana"})
+	alertmanager := specNavlinks("team", LinkConfig{Name: "alertmanager", Service: "alertmanager-operated", Port: "9093", Icon: "alertmanager"})
+	changedGrafana := specNavlinks("team", LinkConfig{Name: "grafana", Service: "project-monitoring-grafana", Port: "3000", Icon: "grafana"})
+
+	created, changed, deleted := diffNavlinks(
+		[]uiv1.NavLink{prometheus, grafana, alertmanager},
+		[]uiv1.NavLink{prometheus, changedGrafana},
+	)
+	if len(created) != 0 {
+		t.Errorf("created = %v, want none", navlinkList(created))
+	}
+	if navlinkList(changed) != grafana.Name {
+		t.Errorf("changed = %v, want %v", navlinkList(changed), grafana.Name)
+	}
+	if navlinkList(deleted) != alertmanager.Name {
+		t.Errorf("deleted = %v, want %v", navlinkList(deleted), alertmanager.Name)
+	}
+
+	created, changed, deleted = diffNavlinks(nil, []uiv1.NavLink{prometheus})
+	if navlinkList(created) != prometheus.Name || len(changed) != 0 || len(deleted) != 0 {
+		t.Errorf("diff from nothing = %v, %v, %v, want only %v created", navlinkList(created), navlinkList(changed), navlinkList(deleted), prometheus.Name)
+	}
+}
+
+func TestPatchNavlink(t *testing.T) {
+	grafana := specNavlinks("team", LinkConfig{Name: "grafana", Service: "project-monitoring-grafana", Port: "80", Icon: "grafana"})
+	client := NewSimpleFakeUiV1(&grafana).NavLinks()
+
+	changed := specNavlinks("team", LinkConfig{Name: "grafana", Service: "project-monitoring-grafana", Port: "3000", Icon: "grafana"})
+	if err := patchNavlink(context.Background(), client, &changed); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := client.Get(context.Background(), grafana.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got.Spec, changed.Spec) {
+		t.Errorf("spec = %+v, want %+v", got.Spec, changed.Spec)
+	}
+}
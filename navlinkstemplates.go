@@ -0,0 +1,138 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/golang/glog"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	uiv1 "github.com/rancher/rancher/pkg/apis/ui.cattle.io/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+)
+
+// templateLabel is the name of the NavLinkTemplate a navlink was created from
+const templateLabel = "navlinks.cattle.io/template"
+
+// newNavlinkTemplateInformer returns an informer of the NavLinkTemplates of the cluster
+func newNavlinkTemplateInformer(templates NavLinkTemplateInterface) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+				return templates.List(context.Background(), opts)
+			},
+			WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
+				return templates.Watch(context.Background(), opts)
+			},
+		},
+		&NavLinkTemplate{},
+		0,
+		cache.Indexers{},
+	)
+}
+
+// onTemplateChange calls f on each added, changed or deleted NavLinkTemplate until the context is done
+func onTemplateChange(ctx context.Context, informer cache.SharedIndexInformer, f func()) error {
+	registration, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(interface{}) { f() },
+		UpdateFunc: func(interface{}, interface{}) { f() },
+		DeleteFunc: func(interface{}) { f() },
+	})
+	if err != nil {
+		return err
+	}
+	go func() {
+		<-ctx.Done()
+		if err := informer.RemoveEventHandler(registration); err != nil {
+			glog.Errorf("error removing navlink template handler: %v", err)
+		}
+	}()
+	return nil
+}
+
+// templateNavlinks returns the navlinks of the NavLinkTemplates selecting the Prometheus,
+// invalid templates are skipped
+func (b *navlinkBuilder) templateNavlinks(ctx context.Context, prom *monitoringv1.Prometheus, seen map[string]bool) []uiv1.NavLink {
+	if b.templates == nil {
+		return nil
+	}
+	templates := make([]*NavLinkTemplate, 0)
+	for _, obj := range b.templates.List() {
+		templates = append(templates, obj.(*NavLinkTemplate))
+	}
+	sort.Slice(templates, func(i, j int) bool { return templates[i].Name < templates[j].Name })
+
+	var navlinks []uiv1.NavLink
+	for _, tmpl := range templates {
+		selector := labels.Everything()
+		if tmpl.Spec.Selector != nil {
+			var err error
+			if selector, err = metav1.LabelSelectorAsSelector(tmpl.Spec.Selector); err != nil {
+				glog.Errorf("navlink template %s skipped, invalid selector: %v", tmpl.Name, err)
+				continue
+			}
+		}
+		if !selector.Matches(labels.Set(prom.Labels)) {
+			continue
+		}
+		nl, err := b.templateNavlink(ctx, prom, tmpl)
+		if err != nil {
+			glog.Errorf("navlink template %s skipped for %s/%s: %v", tmpl.Name, prom.Namespace, prom.Name, err)
+			continue
+		}
+		if seen[nl.Name] {
+			glog.Errorf("navlink template %s skipped for %s/%s: duplicate navlink name %s", tmpl.Name, prom.Namespace, prom.Name, nl.Name)
+			continue
+		}
+		seen[nl.Name] = true
+		navlinks = append(navlinks, nl)
+	}
+	return navlinks
+}
+
+// templateNavlink returns the navlink of the template for the Prometheus, name and unset
+// group and labels are rendered with the naming templates
+func (b *navlinkBuilder) templateNavlink(ctx context.Context, prom *monitoringv1.Prometheus, tmpl *NavLinkTemplate) (uiv1.NavLink, error) {
+	spec := *tmpl.Spec.NavLinkSpec.DeepCopy()
+	link := LinkConfig{Name: tmpl.Name, Service: tmpl.Name, Label: spec.Label}
+	if spec.ToService != nil {
+		if spec.ToService.Name == "" {
+			return uiv1.NavLink{}, fmt.Errorf("toService without name")
+		}
+		spec.ToService.Namespace = prom.Namespace
+		link.Service = spec.ToService.Name
+	}
+
+	nl := uiv1.NavLink{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{
+				managedByLabel:       managedBy,
+				sourceNamespaceLabel: prom.Namespace,
+				templateLabel:        tmpl.Name,
+			},
+		},
+		Spec: spec,
+	}
+	if err := b.naming.apply(&nl, newNamingData(ctx, b.namespaces, prom, link)); err != nil {
+		return uiv1.NavLink{}, err
+	}
+	if spec.Group != "" {
+		nl.Spec.Group = spec.Group
+	}
+	if spec.Label != "" {
+		nl.Spec.Label = spec.Label
+	}
+	if spec.SideLabel != "" {
+		nl.Spec.SideLabel = spec.SideLabel
+	}
+	if nl.Spec.ToService != nil && b.config.direct(link) {
+		b.routes.directURL(ctx, &nl)
+	}
+	setNavlinkSource(&nl, monitoringv1.SchemeGroupVersion.String(), monitoringv1.PrometheusesKind, prom)
+	return nl, nil
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	uiv1 "github.com/rancher/rancher/pkg/apis/ui.cattle.io/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestTemplateNavlinks(t *testing.T) {
+	port := intstr.FromInt(8080)
+	templates := []*NavLinkTemplate{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "docs"},
+			Spec: NavLinkTemplateSpec{
+				NavLinkSpec: uiv1.NavLinkSpec{ToURL: "https://docs.example.com", Label: "Docs"},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "loki"},
+			Spec: NavLinkTemplateSpec{
+				NavLinkSpec: uiv1.NavLinkSpec{
+					Group:     "logging",
+					ToService: &uiv1.NavLinkTargetService{Name: "loki", Scheme: "http", Port: &port},
+				},
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"logging": "true"}},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "invalid"},
+			Spec: NavLinkTemplateSpec{
+				NavLinkSpec: uiv1.NavLinkSpec{ToService: &uiv1.NavLinkTargetService{}},
+			},
+		},
+	}
+	store := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	for _, tmpl := range templates {
+		if err := store.Add(tmpl); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name   string
+		labels map[string]string
+		want   []string
+	}{
+		{
+			name: "not selected",
+			want: []string{"monitoring-team-docs"},
+		},
+		{
+			name:   "selected",
+			labels: map[string]string{"logging": "true"},
+			want:   []string{"monitoring-team-docs", "monitoring-team-loki"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			builder := testBuilder(t, &Config{})
+			builder.templates = store
+			prom := testPrometheus("team", "prometheus")
+			prom.Labels = tt.labels
+
+			navlinks, err := builder.prometheusNavlinks(context.Background(), prom)
+			if err != nil {
+				t.Fatal(err)
+			}
+			names := []string{}
+			for _, nl := range navlinks {
+				names = append(names, nl.Name)
+				if nl.Labels[templateLabel] == "" || nl.Labels[sourceKindLabel] != "Prometheus" {
+					t.Errorf("navlink %s labels = %v", nl.Name, nl.Labels)
+				}
+			}
+			if !reflect.DeepEqual(names, tt.want) {
+				t.Fatalf("navlinks = %v, want %v", names, tt.want)
+			}
+			if len(navlinks) > 1 {
+				loki := navlinks[1]
+				if loki.Spec.Group != "logging" || loki.Spec.ToService.Namespace != "team" {
+					t.Errorf("loki group = %q, namespace = %q", loki.Spec.Group, loki.Spec.ToService.Namespace)
+				}
+			}
+			if docs := navlinks[0]; docs.Spec.Group != "monitoring-team" || docs.Spec.Label != "Docs" {
+				t.Errorf("docs group = %q, label = %q", docs.Spec.Group, docs.Spec.Label)
+			}
+		})
+	}
+}
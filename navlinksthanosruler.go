@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	uiv1 "github.com/rancher/rancher/pkg/apis/ui.cattle.io/v1"
+)
+
+// thanosRulerLink is the link to the service the Prometheus Operator creates for ThanosRulers
+func (c *Config) thanosRulerLink(tr *monitoringv1.ThanosRuler) LinkConfig {
+	port := tr.Spec.PortName
+	if port == "" {
+		port = "10902"
+	}
+	icon := c.ThanosRuler.Icon
+	if icon == "" {
+		icon = "thanos"
+	}
+	return LinkConfig{
+		Name:    "thanos-ruler",
+		Service: "thanos-ruler-operated",
+		Port:    port,
+		Icon:    icon,
+		Label:   c.ThanosRuler.Label,
+		Access:  c.ThanosRuler.Access,
+		Scheme:  c.ThanosRuler.Scheme,
+	}
+}
+
+// thanosRulerNavlinks returns the navlink of a ThanosRuler object under its routePrefix,
+// overridden by the annotations of the ThanosRuler
+func (b *navlinkBuilder) thanosRulerNavlinks(ctx context.Context, tr *monitoringv1.ThanosRuler) ([]uiv1.NavLink, error) {
+	if b.config.ThanosRuler.Disabled {
+		return nil, nil
+	}
+	navlinks, err := b.resourceNavlinks(ctx, tr, monitoringv1.SchemeGroupVersion.String(), monitoringv1.ThanosRulerKind, b.config.thanosRulerLink(tr))
+	if err != nil || len(navlinks) == 0 {
+		return nil, err
+	}
+	setWebRoute(&navlinks[0], "", tr.Spec.RoutePrefix)
+	return navlinks, nil
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	v1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestServeThanosRuler(t *testing.T) {
+	tr := &monitoringv1.ThanosRuler{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "team", Name: "ruler", UID: "thanos-ruler-uid"},
+	}
+	navlinks := NewSimpleFakeUiV1(testNavlink("team", "prometheus-operated"))
+	nls := NewNavlinksServerHandler(navlinks.NavLinks(), newFakeSources(), testBuilder(t, defaultConfig()), nil)
+
+	for _, op := range []v1.Operation{v1.Create, v1.Delete} {
+		req := httptest.NewRequest(http.MethodPost, "/validate", bytes.NewReader(testAdmissionReview(t, op, false, tr)))
+		rec := httptest.NewRecorder()
+		nls.serve(rec, req)
+		drainWrites(t, nls)
+		resp := v1.AdmissionReview{}
+		if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+			t.Fatal(err)
+		}
+		if !resp.Response.Allowed {
+			t.Fatalf("%s denied: %s", op, resp.Response.Result.Message)
+		}
+
+		if op == v1.Create {
+			nl, err := navlinks.NavLinks().Get(context.Background(), "monitoring-team-thanos-ruler-operated", metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if nl.Spec.ToService.Port.String() != "10902" || nl.Spec.IconSrc != logoThanos || nl.Labels[sourceKindLabel] != monitoringv1.ThanosRulerKind {
+				t.Errorf("navlink = %+v, icon %t, labels %v", nl.Spec.ToService, nl.Spec.IconSrc == logoThanos, nl.Labels)
+			}
+		}
+	}
+
+	if names := navlinkNames(t, navlinks); !reflect.DeepEqual(names, []string{"monitoring-team-prometheus-operated"}) {
+		t.Errorf("navlinks = %v after delete", names)
+	}
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/golang/glog"
+
+	uiv1 "github.com/rancher/rancher/pkg/apis/ui.cattle.io/v1"
+)
+
+// prometheusService is the service the Prometheus Operator creates for Prometheus objects
+const prometheusService = "prometheus-operated"
+
+// setWebRoute points the navlink to the external URL of a monitoring object when set, or adds
+// the route prefix the web UI is served under to the service path. An invalid external URL is
+// logged and the service is linked. Navlinks to the public URL of an Ingress or HTTPRoute keep its path.
+func setWebRoute(nl *uiv1.NavLink, externalURL string, routePrefix string) {
+	if setExternalURL(nl, externalURL) {
+		return
+	}
+	if routePrefix == "" || nl.Spec.ToService == nil {
+		return
+	}
+	if !strings.HasPrefix(routePrefix, "/") {
+		routePrefix = "/" + routePrefix
+	}
+	nl.Spec.ToService.Path = joinPath(routePrefix, nl.Spec.ToService.Path)
+}
+
+// setExternalURL points the navlink to the external URL and reports whether it did, an invalid
+// external URL is logged and leaves the navlink as it is
+func setExternalURL(nl *uiv1.NavLink, externalURL string) bool {
+	if externalURL == "" {
+		return false
+	}
+	if err := validateExternalURL(externalURL); err != nil {
+		glog.Warningf("navlink %s: %v, linking the service", nl.Name, err)
+		return false
+	}
+	nl.Spec.ToService = nil
+	nl.Spec.ToURL = externalURL
+	return true
+}
+
+// linkScheme returns the scheme of the link, https when the web UI has TLS and the link has none
+func linkScheme(scheme string, tls bool) string {
+	if scheme == "" && tls {
+		return "https"
+	}
+	return scheme
+}
+
+// validateExternalURL checks the external URL is an absolute http or https URL
+func validateExternalURL(externalURL string) error {
+	u, err := url.Parse(externalURL)
+	if err != nil {
+		return fmt.Errorf("externalUrl %q: %w", externalURL, err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("externalUrl %q must be an absolute http or https URL", externalURL)
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestWebRoute(t *testing.T) {
+	tests := []struct {
+		name        string
+		externalURL string
+		routePrefix string
+		annotations map[string]string
+		path        string
+		toURL       string
+	}{
+		{
+			name: "defaults",
+		},
+		{
+			name:        "route prefix",
+			routePrefix: "/prometheus",
+			path:        "/prometheus",
+		},
+		{
+			name:        "route prefix without slash",
+			routePrefix: "prometheus/",
+			path:        "/prometheus",
+		},
+		{
+			name:        "external url",
+			externalURL: "https://prometheus.example.com/team",
+			routePrefix: "/team",
+			toURL:       "https://prometheus.example.com/team",
+		},
+		{
+			name:        "invalid external url",
+			externalURL: "prometheus.example.com",
+			routePrefix: "/prometheus",
+			path:        "/prometheus",
+		},
+		{
+			name:        "service overridden",
+			routePrefix: "/prometheus",
+			annotations: map[string]string{"navlinks.cattle.io/prometheus-service": "prometheus-proxy"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prom := testPrometheus("team", "prometheus")
+			prom.Annotations = tt.annotations
+			prom.Spec.ExternalURL = tt.externalURL
+			prom.Spec.RoutePrefix = tt.routePrefix
+
+			navlinks, err := testBuilder(t, defaultConfig()).prometheusNavlinks(context.Background(), prom)
+			if err != nil {
+				t.Fatal(err)
+			}
+			nl := navlinks[0]
+			if nl.Spec.ToURL != tt.toURL {
+				t.Errorf("toURL = %q, want %q", nl.Spec.ToURL, tt.toURL)
+			}
+			if tt.toURL == "" && nl.Spec.ToService.Path != tt.path {
+				t.Errorf("path = %q, want %q", nl.Spec.ToService.Path, tt.path)
+			}
+			if grafana := navlinks[1]; grafana.Spec.ToService.Path != "" {
+				t.Errorf("grafana path = %q, want none", grafana.Spec.ToService.Path)
+			}
+		})
+	}
+}
+
+func TestWebRouteAlertmanager(t *testing.T) {
+	am := testAlertmanager("team", "main")
+	am.Spec.RoutePrefix = "/alertmanager"
+	if nl := testAlertmanagerNavlink(t, am); nl.Spec.ToService.Path != "/alertmanager" {
+		t.Errorf("path = %q, want %q", nl.Spec.ToService.Path, "/alertmanager")
+	}
+
+	tr := &monitoringv1.ThanosRuler{ObjectMeta: metav1.ObjectMeta{Namespace: "team", Name: "ruler"}}
+	tr.Spec.RoutePrefix = "/ruler"
+	navlinks, err := testBuilder(t, defaultConfig()).thanosRulerNavlinks(context.Background(), tr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path := navlinks[0].Spec.ToService.Path; path != "/ruler" {
+		t.Errorf("thanos ruler path = %q, want %q", path, "/ruler")
+	}
+}
+
+func TestWebTLS(t *testing.T) {
+	tls := &monitoringv1.WebTLSConfig{}
+	prom := testPrometheus("team", "prometheus")
+	prom.Spec.Web = &monitoringv1.PrometheusWebSpec{WebConfigFileFields: monitoringv1.WebConfigFileFields{TLSConfig: tls}}
+	am := testAlertmanager("team", "main")
+	am.Spec.Web = &monitoringv1.AlertmanagerWebSpec{WebConfigFileFields: monitoringv1.WebConfigFileFields{TLSConfig: tls}}
+	grafana := &Grafana{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "team", Name: "grafana"},
+		Spec:       GrafanaSpec{Config: map[string]map[string]string{"server": {"protocol": "https"}}},
+	}
+
+	tests := []struct {
+		name   string
+		config func(*Config)
+		obj    metav1.Object
+		scheme string
+	}{
+		{
+			name:   "prometheus tls",
+			obj:    prom,
+			scheme: "https",
+		},
+		{
+			name:   "prometheus without tls",
+			obj:    testPrometheus("team", "prometheus"),
+			scheme: "http",
+		},
+		{
+			name:   "prometheus link scheme",
+			config: func(c *Config) { c.Links[0].Scheme = "http" },
+			obj:    prom,
+			scheme: "http",
+		},
+		{
+			name:   "alertmanager tls",
+			obj:    am,
+			scheme: "https",
+		},
+		{
+			name:   "alertmanager scheme",
+			config: func(c *Config) { c.Alertmanager.Scheme = "http" },
+			obj:    am,
+			scheme: "http",
+		},
+		{
+			name:   "grafana protocol",
+			obj:    grafana,
+			scheme: "https",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := defaultConfig()
+			if tt.config != nil {
+				tt.config(config)
+			}
+			navlinks, err := testBuilder(t, config).navlinks(context.Background(), tt.obj)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if scheme := navlinks[0].Spec.ToService.Scheme; scheme != tt.scheme {
+				t.Errorf("scheme = %q, want %q", scheme, tt.scheme)
+			}
+			if _, ok := tt.obj.(*monitoringv1.Prometheus); ok && navlinks[1].Spec.ToService.Scheme != "http" {
+				t.Errorf("grafana scheme = %q, want http", navlinks[1].Spec.ToService.Scheme)
+			}
+		})
+	}
+}
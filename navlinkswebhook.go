@@ -1,18 +1,17 @@
 package main
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/golang/glog"
-	"k8s.io/client-go/rest"
 
-	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	uiv1 "github.com/rancher/rancher/pkg/apis/ui.cattle.io/v1"
 	v1 "k8s.io/api/admission/v1"
-	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -27,15 +26,75 @@ var (
 
 // NavlinksServerHandler listen to admission requests and serve responses
 type NavlinksServerHandler struct {
+	navlinks NavLinkInterface
+	sources  *sourceClients
+	builder  *navlinkBuilder
+	leader   *leaderStatus
+	changes  *changeQueue
+	writes   *writeQueue
+}
+
+// NewNavlinksServerHandler returns a handler queueing the changes of admitted source objects for the given
+// clients, the changes are resolved to navlinks by running the change queue and written by running the write queue
+func NewNavlinksServerHandler(navlinks NavLinkInterface, sources *sourceClients, builder *navlinkBuilder, leader *leaderStatus) *NavlinksServerHandler {
+	writes := newWriteQueue(navlinks, defaultWriteRateLimiter())
+	return &NavlinksServerHandler{
+		navlinks: navlinks,
+		sources:  sources,
+		builder:  builder,
+		leader:   leader,
+		changes:  newChangeQueue(navlinks, builder, writes, defaultWriteRateLimiter()),
+		writes:   writes,
+	}
+}
+
+// drain resolves the queued changes and writes the navlinks within the timeout
+func (nls *NavlinksServerHandler) drain(timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+	// the changes queue writes, so they are resolved first
+	resolved := nls.changes.drain(time.Until(deadline))
+	return nls.writes.drain(time.Until(deadline)) && resolved
 }
 
 func (nls *NavlinksServerHandler) healthz(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 	w.Write([]byte("ok"))
+	if nls.leader == nil {
+		return
+	}
+	leader, self := nls.leader.get()
+	if leader == "" {
+		leader = "unknown"
+	}
+	fmt.Fprintf(w, "\nidentity: %s\nleader: %s\nis leader: %t", nls.leader.identity, leader, self)
 }
 
 func (nls *NavlinksServerHandler) serve(w http.ResponseWriter, r *http.Request) {
 
+	arRequest, ok := readAdmissionReview(w, r, "/validate")
+	if !ok {
+		return
+	}
+
+	// switch operation mode
+	operation := arRequest.Request.Operation
+	switch operation {
+	case v1.Create:
+		nls.create(w, r, arRequest)
+	case v1.Update:
+		nls.update(w, r, arRequest)
+	case v1.Delete:
+		nls.delete(w, r, arRequest)
+	default:
+		glog.Error("wrong operation mode: ", operation)
+		nls.response(true, "Navlinks skipped for operation "+string(operation), w, arRequest)
+	}
+
+}
+
+// readAdmissionReview decodes the admission review of the request to the path, on failure
+// the error is written to the response
+func readAdmissionReview(w http.ResponseWriter, r *http.Request, path string) (*v1.AdmissionReview, bool) {
 	var body []byte
 	if r.Body != nil {
 		if data, err := io.ReadAll(r.Body); err == nil {
@@ -43,188 +102,160 @@ func (nls *NavlinksServerHandler) serve(w http.ResponseWriter, r *http.Request)
 		}
 	}
 
-	// Url path of metrics
-	if r.URL.Path == "/metrics" {
-		w.WriteHeader(http.StatusOK)
-		return
-	}
-
 	// Url path of admission
-	if r.URL.Path != "/validate" {
-		glog.Error("no validate")
-		http.Error(w, "no validate", http.StatusBadRequest)
-		return
+	if r.URL.Path != path {
+		glog.Error("no ", path)
+		http.Error(w, "no "+strings.TrimPrefix(path, "/"), http.StatusBadRequest)
+		return nil, false
 	}
 
 	if len(body) == 0 {
 		glog.Error("empty body")
 		http.Error(w, "empty body", http.StatusBadRequest)
-		return
+		return nil, false
 	}
 
 	// count each request for prometheus metric
 	opsProcessed.Inc()
 	arRequest := v1.AdmissionReview{}
-	if err := json.Unmarshal(body, &arRequest); err != nil {
+	if err := json.Unmarshal(body, &arRequest); err != nil || arRequest.Request == nil {
 		glog.Error("incorrect body")
 		http.Error(w, "incorrect body", http.StatusBadRequest)
-		return
+		return nil, false
 	}
+	return &arRequest, true
+}
 
-	// creates the in-cluster config
-	config, err := rest.InClusterConfig()
-	if err != nil {
-		glog.Error("cant get InCluster config: ", err)
-		nls.response(false, "InCluster config failed", w, &arRequest)
+// readSource decodes the raw object of the admitted kind, the response is written on failure
+func (nls *NavlinksServerHandler) readSource(w http.ResponseWriter, raw []byte, arRequest *v1.AdmissionReview) (metav1.Object, bool) {
+	obj := newSource(arRequest.Request.Kind.Kind)
+	if obj == nil {
+		glog.Error("unsupported kind: ", arRequest.Request.Kind.Kind)
+		nls.response(true, "Navlinks skipped for kind "+arRequest.Request.Kind.Kind, w, arRequest)
+		return nil, false
 	}
-	// creates the clientset
-	clientset := NewForConfigOrDie(config)
-	if err != nil {
-		glog.Error("cant setup clientset: ", arRequest.Request.Namespace)
-		nls.response(false, "Setup clientset failed", w, &arRequest)
+	if len(raw) == 0 {
+		return obj, true
+	}
+	if err := json.Unmarshal(raw, obj); err != nil {
+		glog.Errorf("error deserializing %s", arRequest.Request.Kind.Kind)
+		nls.response(false, "Deserializing failed", w, arRequest)
+		return nil, false
 	}
+	return obj, true
+}
 
-	// check if navlink resource is available on api server
-	_, err = clientset.Navlinks().List(context.Background(), metav1.ListOptions{})
-	if err != nil {
-		glog.Error("navlinks resource not available: ", err)
-		nls.response(true, "Navlink resource not available, skill all", w, &arRequest)
+// create validates the admitted source object and queues its change, its navlinks are resolved by the workers
+func (nls *NavlinksServerHandler) create(w http.ResponseWriter, r *http.Request, arRequest *v1.AdmissionReview) {
+	obj, ok := nls.readSource(w, arRequest.Request.Object.Raw, arRequest)
+	if !ok {
+		return
 	}
 
-	// switch operation mode
-	operation := arRequest.Request.Operation
-	switch operation {
-	case v1.Create:
+	ns := obj.GetNamespace()
+	if len(ns) == 0 {
+		glog.Errorf("No namespace found %s/%s", obj.GetName(), obj.GetNamespace())
+		nls.response(true, "Navlinks create skipped", w, arRequest)
+		return
+	}
 
-		raw := arRequest.Request.Object.Raw
-		prom := monitoringv1.Prometheus{}
-		if err := json.Unmarshal(raw, &prom); err != nil {
-			glog.Error("error deserializing pod")
-			nls.response(false, "Deserializing failed", w, &arRequest)
-			return
-		}
+	// only invalid source objects are denied, the builder doesn't look anything up
+	navlinks, err := nls.builder.offline().navlinks(r.Context(), obj)
+	if err != nil {
+		glog.Errorf("error building navlinks: %v", err)
+		nls.response(false, "Navlinks building failed: "+err.Error(), w, arRequest)
+		return
+	}
+	if isDryRun(arRequest) {
+		glog.Info("dry run, navlinks not created for ", ns)
+		nls.response(true, "Navlinks create skipped on dry run, would create: "+navlinkList(navlinks), w, arRequest)
+		return
+	}
 
-		ns := prom.Namespace
-		if len(ns) == 0 {
-			glog.Errorf("No namespace found %s/%s", prom.Name, prom.Namespace)
-			resp, err := json.Marshal(admissionResponse(200, true, "Success", "Navlinks create skipped", &arRequest))
-			if err != nil {
-				glog.Errorf("Can't encode response: %v", err)
-				http.Error(w, fmt.Sprintf("could not encode response: %v", err), http.StatusInternalServerError)
-			}
-			if _, err := w.Write(resp); err != nil {
-				glog.Errorf("Can't write response: %v", err)
-				http.Error(w, fmt.Sprintf("could not write response: %v", err), http.StatusInternalServerError)
-			}
-			return
-		}
-		// create navlink resource prometheus-operated
-		navPrometheus := specNavlinks(ns, "prometheus-operated", "9090", string(arRequest.Request.UID), logoPrometheus)
-		_, err = clientset.Navlinks().Create(context.TODO(), &navPrometheus, metav1.CreateOptions{})
-		if err != nil {
-			if k8serrors.IsAlreadyExists(err) {
-				glog.Error("navlinks prometheus already exists for ", ns)
-				nls.response(true, "Navlink prometheus already exists, skipped", w, &arRequest)
-				return
-			}
-			glog.Errorf("error creating navlinks: %v", err)
-			nls.response(false, "Navlink prometheus creating failed", w, &arRequest)
-		}
-		glog.Info("navlinks created: ", navPrometheus.Name)
+	nls.changes.add(&sourceChange{obj: obj, user: arRequest.Request.UserInfo})
+	glog.Infof("navlinks of %s %s/%s queued for creation", sourceKind(obj), ns, obj.GetName())
 
-		// create navlink resource alertmanager-operated
-		navAlertManager := specNavlinks(ns, "alertmanager-operated", "9093", string(arRequest.Request.UID), logoAlertmanager)
-		_, err = clientset.Navlinks().Create(context.TODO(), &navAlertManager, metav1.CreateOptions{})
-		if err != nil {
-			if k8serrors.IsAlreadyExists(err) {
-				glog.Error("navlinks alertmanager already exists for ", ns)
-				nls.response(true, "Navlink alertmanager already exists, skipped", w, &arRequest)
-				return
-			}
-			glog.Errorf("error creating navlinks: %v", err)
-			nls.response(false, "Navlink alertmanager creating failed", w, &arRequest)
-		}
-		glog.Info("navlinks created: ", navAlertManager.Name)
+	nls.response(true, "Navlinks create", w, arRequest)
+}
 
-		// create navlink resource prometheus-monitoring-grafana
-		navGrafana := specNavlinks(ns, "project-monitoring-grafana", "80", string(arRequest.Request.UID), logoGrafana)
-		_, err = clientset.Navlinks().Create(context.TODO(), &navGrafana, metav1.CreateOptions{})
-		if err != nil {
-			if k8serrors.IsAlreadyExists(err) {
-				glog.Error("navlinks grafana already exists for ", ns)
-				nls.response(true, "Navlink grafana already exists, skipped", w, &arRequest)
-				return
-			}
-			glog.Errorf("error creating navlinks: %v", err)
-			nls.response(false, "Navlink grafana creating failed", w, &arRequest)
-		}
-		glog.Info("navlinks created: ", navGrafana.Name)
+// update validates the updated source object and queues its change, the navlinks of objects being
+// deleted are left to the delete or the finalizer
+func (nls *NavlinksServerHandler) update(w http.ResponseWriter, r *http.Request, arRequest *v1.AdmissionReview) {
+	old, ok := nls.readSource(w, arRequest.Request.OldObject.Raw, arRequest)
+	if !ok {
+		return
+	}
+	obj, ok := nls.readSource(w, arRequest.Request.Object.Raw, arRequest)
+	if !ok {
+		return
+	}
+	// updates of objects being deleted, as the removal of the finalizer, are never blocked
+	if obj.GetDeletionTimestamp() != nil {
+		nls.response(true, "Navlinks skipped for object being deleted", w, arRequest)
+		return
+	}
 
-		resp, err := json.Marshal(admissionResponse(200, true, "Success", "Navlinks create", &arRequest))
-		if err != nil {
-			glog.Errorf("Can't encode response: %v", err)
-			http.Error(w, fmt.Sprintf("could not encode response: %v", err), http.StatusInternalServerError)
-		}
-		if _, err := w.Write(resp); err != nil {
-			glog.Errorf("Can't write response: %v", err)
-			http.Error(w, fmt.Sprintf("could not write response: %v", err), http.StatusInternalServerError)
-		}
-	case v1.Delete:
-		// delete navlink resource prometheus-operated
-		navPrometheus := specNavlinks(arRequest.Request.Namespace, "prometheus-operated", "9090", string(arRequest.Request.UID), logoPrometheus)
-		err = clientset.Navlinks().Delete(context.TODO(), navPrometheus.Name, metav1.DeleteOptions{})
+	builder := nls.builder.offline()
+	after, err := builder.navlinks(r.Context(), obj)
+	if err != nil {
+		glog.Errorf("error building navlinks: %v", err)
+		nls.response(false, "Navlinks building failed: "+err.Error(), w, arRequest)
+		return
+	}
+	if isDryRun(arRequest) {
+		before, err := builder.navlinks(r.Context(), old)
 		if err != nil {
-			if k8serrors.IsNotFound(err) {
-				glog.Error("navlinks prometheus already deleted for ", arRequest.Request.Namespace)
-				nls.response(true, "Navlink prometheus already exists, skipped", w, &arRequest)
-				return
-			}
-			glog.Errorf("error deleting navlinks: %v", err)
-			nls.response(false, "Navlink prometheus deleting failed", w, &arRequest)
+			glog.Errorf("error building navlinks: %v", err)
 		}
-		glog.Info("navlinks deleted: ", navPrometheus.Name)
+		created, changed, deleted := diffNavlinks(before, after)
+		glog.Info("dry run, navlinks not updated for ", obj.GetNamespace())
+		nls.response(true, fmt.Sprintf("Navlinks update skipped on dry run, would create: %s, patch: %s, delete: %s",
+			navlinkList(created), navlinkList(changed), navlinkList(deleted)), w, arRequest)
+		return
+	}
 
-		// delete navlink resource alertmanager-operated
-		navAlertManager := specNavlinks(arRequest.Request.Namespace, "alertmanager-operated", "9093", string(arRequest.Request.UID), logoAlertmanager)
-		err = clientset.Navlinks().Delete(context.TODO(), navAlertManager.Name, metav1.DeleteOptions{})
-		if err != nil {
-			if k8serrors.IsNotFound(err) {
-				glog.Error("navlinks alertmanager already deleted for ", arRequest.Request.Namespace)
-				nls.response(true, "Navlink alertmanager already deleted, skipped", w, &arRequest)
-				return
-			}
-			glog.Errorf("error deleting navlinks: %v", err)
-			nls.response(false, "Navlink alertmanager deleting failed", w, &arRequest)
-		}
-		glog.Info("navlinks deleted: ", navAlertManager.Name)
+	nls.changes.add(&sourceChange{old: old, obj: obj, user: arRequest.Request.UserInfo})
+	glog.Infof("navlinks of %s %s/%s queued for update", sourceKind(obj), obj.GetNamespace(), obj.GetName())
 
-		// delete navlink resource prometheus-monitoring-grafana
-		navGrafana := specNavlinks(arRequest.Request.Namespace, "project-monitoring-grafana", "80", string(arRequest.Request.UID), logoGrafana)
-		err = clientset.Navlinks().Delete(context.TODO(), navGrafana.Name, metav1.DeleteOptions{})
-		if err != nil {
-			if k8serrors.IsNotFound(err) {
-				glog.Error("navlinks grafana already deleted for ", arRequest.Request.Namespace)
-				nls.response(true, "Navlink grafana already deleted, skipped", w, &arRequest)
-				return
-			}
-			glog.Errorf("error deleting navlinks: %v", err)
-			nls.response(false, "Navlink grafana deleting failed", w, &arRequest)
-		}
-		glog.Info("navlinks deleted: ", navGrafana.Name)
+	nls.response(true, "Navlinks update", w, arRequest)
+}
 
-		resp, err := json.Marshal(admissionResponse(200, true, "Success", "Navlinks delete", &arRequest))
+// delete queues the change of the deleted source object, the workers delete the navlinks of its kind in
+// the namespace. Grafanas and Services have their own navlinks and delete only them.
+func (nls *NavlinksServerHandler) delete(w http.ResponseWriter, r *http.Request, arRequest *v1.AdmissionReview) {
+	obj, ok := nls.readSource(w, arRequest.Request.OldObject.Raw, arRequest)
+	if !ok {
+		return
+	}
+	if isDryRun(arRequest) {
+		// the navlinks of the object, without listing the existing ones
+		navlinks, err := nls.builder.offline().navlinks(r.Context(), obj)
 		if err != nil {
-			glog.Errorf("Can't encode response: %v", err)
-			http.Error(w, fmt.Sprintf("could not encode response: %v", err), http.StatusInternalServerError)
-		}
-		if _, err := w.Write(resp); err != nil {
-			glog.Errorf("Can't write response: %v", err)
-			http.Error(w, fmt.Sprintf("could not write response: %v", err), http.StatusInternalServerError)
+			glog.Errorf("error building navlinks: %v", err)
 		}
-	default:
-		glog.Error("wrong operation mode")
+		glog.Info("dry run, navlinks not deleted for ", arRequest.Request.Namespace)
+		nls.response(true, "Navlinks delete skipped on dry run, would delete: "+navlinkList(navlinks), w, arRequest)
+		return
 	}
 
+	nls.changes.add(&sourceChange{old: obj, user: arRequest.Request.UserInfo})
+	glog.Infof("navlinks of %s %s/%s queued for deletion", sourceKind(obj), obj.GetNamespace(), obj.GetName())
+
+	nls.response(true, "Navlinks delete", w, arRequest)
+}
+
+// isDryRun reports whether the admission request must not have side effects
+func isDryRun(arRequest *v1.AdmissionReview) bool {
+	return arRequest.Request.DryRun != nil && *arRequest.Request.DryRun
+}
+
+// navlinkList returns the comma-separated names of the navlinks
+func navlinkList(navlinks []uiv1.NavLink) string {
+	names := make([]string, 0, len(navlinks))
+	for _, nl := range navlinks {
+		names = append(names, nl.Name)
+	}
+	return strings.Join(names, ",")
 }
 
 func (nls *NavlinksServerHandler) response(allowed bool, message string, w http.ResponseWriter, arRequest *v1.AdmissionReview) {
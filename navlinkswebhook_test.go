@@ -0,0 +1,430 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"testing"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	uiv1 "github.com/rancher/rancher/pkg/apis/ui.cattle.io/v1"
+	v1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func testPrometheus(namespace string, name string) *monitoringv1.Prometheus {
+	return &monitoringv1.Prometheus{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "monitoring.coreos.com/v1",
+			Kind:       "Prometheus",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+			UID:       "prometheus-uid",
+		},
+	}
+}
+
+func testNavlink(namespace string, service string) *uiv1.NavLink {
+	nl := specNavlinks(namespace, LinkConfig{Service: service})
+	setNavlinkSource(&nl, monitoringv1.SchemeGroupVersion.String(), monitoringv1.PrometheusesKind, testPrometheus(namespace, "prometheus"))
+	return &nl
+}
+
+func testBuilder(t *testing.T, config *Config, namespaces ...runtime.Object) *navlinkBuilder {
+	t.Helper()
+	builder, err := newNavlinkBuilder(config, fake.NewSimpleClientset(namespaces...).CoreV1())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return builder
+}
+
+func testAdmissionReview(t *testing.T, operation v1.Operation, dryRun bool, obj metav1.Object) []byte {
+	t.Helper()
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ar := v1.AdmissionReview{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: admissionApi,
+			Kind:       admissionKind,
+		},
+		Request: &v1.AdmissionRequest{
+			UID:       "request-uid",
+			Kind:      metav1.GroupVersionKind{Group: monitoringv1.SchemeGroupVersion.Group, Version: monitoringv1.SchemeGroupVersion.Version, Kind: sourceKind(obj)},
+			Namespace: obj.GetNamespace(),
+			Name:      obj.GetName(),
+			Operation: operation,
+			DryRun:    &dryRun,
+			UserInfo:  authenticationv1.UserInfo{Username: "developer"},
+		},
+	}
+	if operation != v1.Create {
+		ar.Request.OldObject = runtime.RawExtension{Raw: raw}
+	}
+	if operation != v1.Delete {
+		ar.Request.Object = runtime.RawExtension{Raw: raw}
+	}
+	body, err := json.Marshal(ar)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return body
+}
+
+func navlinkNames(t *testing.T, client *FakeUiV1) []string {
+	t.Helper()
+	list, err := client.NavLinks().List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	names := []string{}
+	for _, nl := range list.Items {
+		names = append(names, nl.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestServe(t *testing.T) {
+	errInternal := k8serrors.NewInternalError(errors.New("apiserver unavailable"))
+	allLinks := []string{
+		"monitoring-team-project-monitoring-grafana",
+		"monitoring-team-prometheus-operated",
+	}
+
+	tests := []struct {
+		name        string
+		operation   v1.Operation
+		dryRun      bool
+		navlinks    []runtime.Object
+		reactor     k8stesting.ReactionFunc
+		verb        string
+		allowed     bool
+		message     string
+		wantPending int
+		wantNavlink []string
+	}{
+		{
+			name:        "create",
+			operation:   v1.Create,
+			allowed:     true,
+			message:     "Navlinks create",
+			wantNavlink: allLinks,
+		},
+		{
+			name:        "create already exists",
+			operation:   v1.Create,
+			navlinks:    []runtime.Object{testNavlink("team", "prometheus-operated")},
+			allowed:     true,
+			message:     "Navlinks create",
+			wantNavlink: allLinks,
+		},
+		{
+			name:        "create dry run",
+			operation:   v1.Create,
+			dryRun:      true,
+			allowed:     true,
+			message:     "Navlinks create skipped on dry run, would create: monitoring-team-prometheus-operated,monitoring-team-project-monitoring-grafana",
+			wantNavlink: []string{},
+		},
+		{
+			name:      "create failure",
+			operation: v1.Create,
+			verb:      "create",
+			reactor: func(k8stesting.Action) (bool, runtime.Object, error) {
+				return true, nil, errInternal
+			},
+			allowed:     true,
+			message:     "Navlinks create",
+			wantNavlink: []string{},
+		},
+		{
+			name:      "create resource not available",
+			operation: v1.Create,
+			verb:      "create",
+			reactor: func(k8stesting.Action) (bool, runtime.Object, error) {
+				return true, nil, k8serrors.NewNotFound(navlinksResource.GroupResource(), "")
+			},
+			allowed:     true,
+			message:     "Navlinks create",
+			wantNavlink: []string{},
+		},
+		{
+			name:        "update unchanged",
+			operation:   v1.Update,
+			allowed:     true,
+			message:     "Navlinks update",
+			wantNavlink: []string{},
+		},
+		{
+			name:        "connect",
+			operation:   v1.Connect,
+			allowed:     true,
+			message:     "Navlinks skipped for operation CONNECT",
+			wantNavlink: []string{},
+		},
+		{
+			name:      "delete",
+			operation: v1.Delete,
+			navlinks: []runtime.Object{
+				testNavlink("team", "prometheus-operated"),
+				testNavlink("team", "project-monitoring-grafana"),
+			},
+			allowed:     true,
+			message:     "Navlinks delete",
+			wantNavlink: []string{},
+		},
+		{
+			name:      "delete dry run",
+			operation: v1.Delete,
+			dryRun:    true,
+			navlinks: []runtime.Object{
+				testNavlink("team", "prometheus-operated"),
+				testNavlink("team", "project-monitoring-grafana"),
+			},
+			allowed:     true,
+			message:     "Navlinks delete skipped on dry run, would delete: monitoring-team-prometheus-operated,monitoring-team-project-monitoring-grafana",
+			wantNavlink: allLinks,
+		},
+		{
+			name:        "delete already deleted",
+			operation:   v1.Delete,
+			allowed:     true,
+			message:     "Navlinks delete",
+			wantNavlink: []string{},
+		},
+		{
+			name:        "delete listing failure",
+			operation:   v1.Delete,
+			navlinks:    []runtime.Object{testNavlink("team", "prometheus-operated")},
+			verb:        "list",
+			reactor:     selectedList(errInternal),
+			allowed:     true,
+			message:     "Navlinks delete",
+			wantPending: 1,
+			wantNavlink: []string{"monitoring-team-prometheus-operated"},
+		},
+		{
+			name:        "delete resource not available",
+			operation:   v1.Delete,
+			verb:        "list",
+			reactor:     selectedList(k8serrors.NewNotFound(navlinksResource.GroupResource(), "")),
+			allowed:     true,
+			message:     "Navlinks delete",
+			wantNavlink: []string{},
+		},
+		{
+			name:      "delete failure",
+			operation: v1.Delete,
+			navlinks: []runtime.Object{
+				testNavlink("team", "prometheus-operated"),
+			},
+			verb: "delete",
+			reactor: func(k8stesting.Action) (bool, runtime.Object, error) {
+				return true, nil, errInternal
+			},
+			allowed:     true,
+			message:     "Navlinks delete",
+			wantNavlink: []string{"monitoring-team-prometheus-operated"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			navlinks := NewSimpleFakeUiV1(tt.navlinks...)
+			sources := newFakeSources()
+			if tt.reactor != nil {
+				navlinks.PrependReactor(tt.verb, "navlinks", tt.reactor)
+			}
+			nls := NewNavlinksServerHandler(navlinks.NavLinks(), sources, testBuilder(t, defaultConfig()), nil)
+
+			body := testAdmissionReview(t, tt.operation, tt.dryRun, testPrometheus("team", "prometheus"))
+			req := httptest.NewRequest(http.MethodPost, "/validate", bytes.NewReader(body))
+			rec := httptest.NewRecorder()
+			nls.serve(rec, req)
+			drainWrites(t, nls)
+
+			if rec.Code != http.StatusOK {
+				t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+			}
+			resp := v1.AdmissionReview{}
+			if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("decoding response %q: %v", rec.Body.String(), err)
+			}
+			if resp.Response.UID != "request-uid" {
+				t.Errorf("uid = %q, want %q", resp.Response.UID, "request-uid")
+			}
+			if resp.Response.Allowed != tt.allowed {
+				t.Errorf("allowed = %t, want %t", resp.Response.Allowed, tt.allowed)
+			}
+			if resp.Response.Result.Message != tt.message {
+				t.Errorf("message = %q, want %q", resp.Response.Result.Message, tt.message)
+			}
+
+			// failed changes are retried, changes of a missing navlinks resource are dropped
+			if pending := len(nls.changes.pending); pending != tt.wantPending {
+				t.Errorf("pending changes = %d, want %d", pending, tt.wantPending)
+			}
+			names := navlinkNames(t, navlinks)
+			if !reflect.DeepEqual(names, tt.wantNavlink) {
+				t.Errorf("navlinks = %v, want %v", names, tt.wantNavlink)
+			}
+		})
+	}
+}
+
+func TestServeUpdateDeleting(t *testing.T) {
+	tests := []struct {
+		name     string
+		deleting bool
+		allowed  bool
+		message  string
+	}{
+		{
+			name:    "invalid overrides",
+			allowed: false,
+			message: "Navlinks building failed: link grafana: annotation navlinks.cattle.io/grafana-service: service \"grafana.other\" must be in the namespace of the Prometheus",
+		},
+		{
+			name:     "invalid overrides being deleted",
+			deleting: true,
+			allowed:  true,
+			message:  "Navlinks skipped for object being deleted",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prom := testPrometheus("team", "prometheus")
+			prom.Annotations = map[string]string{overridePrefix + "grafana-service": "grafana.other"}
+			if tt.deleting {
+				now := metav1.Now()
+				prom.DeletionTimestamp = &now
+				prom.Finalizers = []string{cleanupFinalizer}
+			}
+			navlinks := NewSimpleFakeUiV1()
+			nls := NewNavlinksServerHandler(navlinks.NavLinks(), newFakeSources(), testBuilder(t, defaultConfig()), nil)
+
+			body := testAdmissionReview(t, v1.Update, false, prom)
+			rec := httptest.NewRecorder()
+			nls.serve(rec, httptest.NewRequest(http.MethodPost, "/validate", bytes.NewReader(body)))
+
+			resp := v1.AdmissionReview{}
+			if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+				t.Fatal(err)
+			}
+			if resp.Response.Allowed != tt.allowed || resp.Response.Result.Message != tt.message {
+				t.Errorf("allowed = %t, message = %q, want %t, %q", resp.Response.Allowed, resp.Response.Result.Message, tt.allowed, tt.message)
+			}
+			if got := nls.changes.queue.Len(); got != 0 {
+				t.Errorf("queued changes = %d, want none", got)
+			}
+		})
+	}
+}
+
+func TestServeNamespaceLookupFailure(t *testing.T) {
+	config := defaultConfig()
+	config.Naming.Group = `{{ index .NamespaceLabels "team" | default .Namespace }}`
+	navlinks := NewSimpleFakeUiV1()
+	// the namespace of the Prometheus is not found
+	nls := NewNavlinksServerHandler(navlinks.NavLinks(), newFakeSources(), testBuilder(t, config), nil)
+
+	body := testAdmissionReview(t, v1.Create, false, testPrometheus("team", "prometheus"))
+	rec := httptest.NewRecorder()
+	nls.serve(rec, httptest.NewRequest(http.MethodPost, "/validate", bytes.NewReader(body)))
+	drainWrites(t, nls)
+
+	resp := v1.AdmissionReview{}
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatal(err)
+	}
+	if !resp.Response.Allowed || resp.Response.Result.Message != "Navlinks create" {
+		t.Errorf("allowed = %t, message = %q, want the create allowed", resp.Response.Allowed, resp.Response.Result.Message)
+	}
+	nl, err := navlinks.NavLinks().Get(context.Background(), "monitoring-team-prometheus-operated", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nl.Spec.Group != "team" {
+		t.Errorf("group = %q, want team", nl.Spec.Group)
+	}
+}
+
+// selectedList returns a reactor failing the lists with a label selector, lists of all navlinks succeed
+func selectedList(err error) k8stesting.ReactionFunc {
+	return func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.(k8stesting.ListAction).GetListRestrictions().Labels.Empty() {
+			return false, nil, nil
+		}
+		return true, nil, err
+	}
+}
+
+func TestServeInjectedClients(t *testing.T) {
+	navlinks := NewSimpleFakeUiV1()
+	sources := newFakeSources()
+	nls := NewNavlinksServerHandler(navlinks.NavLinks(), sources, testBuilder(t, defaultConfig()), nil)
+
+	for i := 0; i < 2; i++ {
+		body := testAdmissionReview(t, v1.Create, false, testPrometheus("team", "prometheus"))
+		nls.serve(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/validate", bytes.NewReader(body)))
+	}
+	drainWrites(t, nls)
+
+	// the requests write with the injected client and don't check for the navlinks resource
+	verbs := map[string]int{}
+	for _, action := range navlinks.Actions() {
+		verbs[action.GetVerb()]++
+	}
+	if want := map[string]int{"create": 2}; !reflect.DeepEqual(verbs, want) {
+		t.Errorf("navlinks actions = %v, want %v", verbs, want)
+	}
+	if actions := sources.monitoring.(*FakeMonitoringV1).Actions(); len(actions) != 0 {
+		t.Errorf("prometheus actions = %v, want none", actions)
+	}
+}
+
+func TestServeSourceIdentity(t *testing.T) {
+	navlinks := NewSimpleFakeUiV1()
+	nls := NewNavlinksServerHandler(navlinks.NavLinks(), newFakeSources(), testBuilder(t, defaultConfig()), nil)
+
+	body := testAdmissionReview(t, v1.Create, false, testPrometheus("team", "prometheus"))
+	nls.serve(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/validate", bytes.NewReader(body)))
+	drainWrites(t, nls)
+
+	nl, err := navlinks.NavLinks().Get(context.Background(), "monitoring-team-prometheus-operated", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{
+		sourceKindAnnotation:      "Prometheus",
+		sourceNamespaceAnnotation: "team",
+		sourceNameAnnotation:      "prometheus",
+		sourceUIDAnnotation:       "prometheus-uid",
+		requestedByAnnotation:     "developer",
+	}
+	if !reflect.DeepEqual(nl.Annotations, want) {
+		t.Errorf("annotations = %v, want %v", nl.Annotations, want)
+	}
+	if nl.Labels[sourceKindLabel] != "Prometheus" {
+		t.Errorf("label %s = %q, want %q", sourceKindLabel, nl.Labels[sourceKindLabel], "Prometheus")
+	}
+	if len(nl.OwnerReferences) != 0 {
+		t.Errorf("owner references = %v, want none for a namespaced source", nl.OwnerReferences)
+	}
+}
@@ -0,0 +1,245 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	uiv1 "github.com/rancher/rancher/pkg/apis/ui.cattle.io/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/workqueue"
+)
+
+const (
+	maxWriteRetries     = 10
+	writeRetryBaseDelay = time.Second
+	writeRetryMaxDelay  = 5 * time.Minute
+	// writeDrainTimeout is the time waited for the queued writes on shutdown
+	writeDrainTimeout = 10 * time.Second
+)
+
+var (
+	writeQueueDepth = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "navlinks_write_queue_depth",
+		Help: "Number of navlink writes queued by admission requests and not done yet, including retries",
+	})
+	writeLatency = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "navlinks_write_latency_seconds",
+		Help:    "Time from queueing a navlink write to its completion or giving up, including retries",
+		Buckets: []float64{.01, .05, .1, .5, 1, 5, 10, 30, 60, 300, 900},
+	}, []string{"operation", "result"})
+)
+
+// writeQueue applies the navlink writes of admission requests in the background, failed
+// writes are retried with exponential backoff. The latest write of a navlink name wins.
+type writeQueue struct {
+	navlinks NavLinkInterface
+	queue    workqueue.RateLimitingInterface
+
+	mu sync.Mutex
+	// pending is the latest write by navlink name
+	pending map[string]*navlinkWrite
+}
+
+// navlinkWrite creates or takes over the navlink, patches it, or deletes the navlink of the name when it is nil
+type navlinkWrite struct {
+	navlink *uiv1.NavLink
+	patch   bool
+	queued  time.Time
+}
+
+func (w *navlinkWrite) operation() string {
+	switch {
+	case w.navlink == nil:
+		return "delete"
+	case w.patch:
+		return "patch"
+	}
+	return "create"
+}
+
+// newWriteQueue returns a queue writing navlinks with the client, retried after the delays of the rate limiter
+func newWriteQueue(navlinks NavLinkInterface, limiter workqueue.RateLimiter) *writeQueue {
+	return &writeQueue{
+		navlinks: navlinks,
+		queue:    workqueue.NewRateLimitingQueueWithConfig(limiter, workqueue.RateLimitingQueueConfig{Name: "navlink-writes"}),
+		pending:  map[string]*navlinkWrite{},
+	}
+}
+
+// defaultWriteRateLimiter doubles the delay of each retry of a navlink from one second up to five minutes
+func defaultWriteRateLimiter() workqueue.RateLimiter {
+	return workqueue.NewItemExponentialFailureRateLimiter(writeRetryBaseDelay, writeRetryMaxDelay)
+}
+
+// create queues the creation or take over of the navlink, replacing a pending write of the name
+func (q *writeQueue) create(nl *uiv1.NavLink) {
+	q.add(nl.Name, &navlinkWrite{navlink: nl.DeepCopy()})
+}
+
+// patch queues the patch of the changed navlink, created when it doesn't exist, replacing a pending write of the name
+func (q *writeQueue) patch(nl *uiv1.NavLink) {
+	q.add(nl.Name, &navlinkWrite{navlink: nl.DeepCopy(), patch: true})
+}
+
+// delete queues the deletion of the navlink, replacing a pending write of the name
+func (q *writeQueue) delete(name string) {
+	q.add(name, &navlinkWrite{})
+}
+
+func (q *writeQueue) add(name string, write *navlinkWrite) {
+	write.queued = time.Now()
+	q.mu.Lock()
+	q.pending[name] = write
+	writeQueueDepth.Set(float64(len(q.pending)))
+	q.mu.Unlock()
+	// the backoff of failed writes is not carried over to the new write
+	q.queue.Forget(name)
+	q.queue.Add(name)
+}
+
+// Run runs the workers until the context is done, drain before to finish the queued writes
+func (q *writeQueue) Run(ctx context.Context, workers int) {
+	defer utilruntime.HandleCrash()
+	defer q.queue.ShutDown()
+
+	for i := 0; i < workers; i++ {
+		go wait.UntilWithContext(ctx, q.runWorker, time.Second)
+	}
+	<-ctx.Done()
+}
+
+// drain stops queueing writes and waits up to the timeout for the queued writes to finish, it reports
+// whether all writes are done. Writes waiting for their retry are not done.
+func (q *writeQueue) drain(timeout time.Duration) bool {
+	drainQueue(q.queue, timeout)
+	q.mu.Lock()
+	pending := len(q.pending)
+	q.mu.Unlock()
+	if pending > 0 {
+		glog.Errorf("%d navlink writes not done on shutdown", pending)
+		return false
+	}
+	glog.Info("navlink writes drained")
+	return true
+}
+
+// drainQueue shuts down the queue and waits up to the timeout for the workers to process the queued items
+func drainQueue(queue workqueue.Interface, timeout time.Duration) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		// ShutDownWithDrain doesn't wait for items queued and not taken by a worker yet
+		for {
+			queue.ShutDownWithDrain()
+			if queue.Len() == 0 || ctx.Err() != nil {
+				return
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		// releases ShutDownWithDrain, workers exit after their current item
+		queue.ShutDown()
+	}
+}
+
+func (q *writeQueue) runWorker(ctx context.Context) {
+	for q.processNextItem(ctx) {
+	}
+}
+
+func (q *writeQueue) processNextItem(ctx context.Context) bool {
+	key, quit := q.queue.Get()
+	if quit {
+		return false
+	}
+	defer q.queue.Done(key)
+
+	name := key.(string)
+	q.mu.Lock()
+	write, found := q.pending[name]
+	q.mu.Unlock()
+	if !found {
+		q.queue.Forget(key)
+		return true
+	}
+
+	result := "success"
+	err := q.write(ctx, name, write)
+	switch {
+	case err == nil:
+	case resourceUnavailable(err):
+		// retries don't help until the NavLink CRD is installed
+		glog.Errorf("navlinks resource not available, dropping write of navlink %s: %v", name, err)
+		result = "failure"
+	case q.queue.NumRequeues(key) < maxWriteRetries:
+		glog.Errorf("error writing navlink %s, retrying: %v", name, err)
+		q.queue.AddRateLimited(key)
+		return true
+	default:
+		glog.Errorf("error writing navlink %s, giving up: %v", name, err)
+		result = "failure"
+	}
+	writeLatency.WithLabelValues(write.operation(), result).Observe(time.Since(write.queued).Seconds())
+	q.mu.Lock()
+	// a write queued meanwhile is processed again
+	if q.pending[name] == write {
+		delete(q.pending, name)
+	}
+	writeQueueDepth.Set(float64(len(q.pending)))
+	q.mu.Unlock()
+	q.queue.Forget(key)
+	return true
+}
+
+// write creates or patches the navlink of the write, or deletes the navlink of the name without navlink
+func (q *writeQueue) write(ctx context.Context, name string, write *navlinkWrite) error {
+	if write.navlink != nil && write.patch {
+		err := patchNavlink(ctx, q.navlinks, write.navlink)
+		if !k8serrors.IsNotFound(err) {
+			return err
+		}
+	}
+	if write.navlink != nil {
+		return createNavlink(ctx, q.navlinks, write.navlink.DeepCopy())
+	}
+	err := q.navlinks.Delete(ctx, name, metav1.DeleteOptions{})
+	if k8serrors.IsNotFound(err) {
+		glog.Error("navlinks already deleted: ", name)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("deleting navlink %s: %w", name, err)
+	}
+	glog.Info("navlinks deleted: ", name)
+	return nil
+}
+
+// resourceUnavailable reports whether the error is of the navlinks resource missing on the API server, not of a navlink.
+// Requests to the collection of a missing resource, as creations, fail with NotFound without name.
+func resourceUnavailable(err error) bool {
+	if meta.IsNoMatchError(err) {
+		return true
+	}
+	var status k8serrors.APIStatus
+	if !k8serrors.IsNotFound(err) || !errors.As(err, &status) {
+		return false
+	}
+	details := status.Status().Details
+	return details == nil || details.Name == ""
+}
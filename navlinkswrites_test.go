@@ -0,0 +1,275 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/admission/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/util/workqueue"
+)
+
+func TestWriteQueue(t *testing.T) {
+	errInternal := k8serrors.NewInternalError(errors.New("apiserver unavailable"))
+
+	tests := []struct {
+		name        string
+		operation   v1.Operation
+		navlinks    []runtime.Object
+		verb        string
+		failures    int
+		message     string
+		wantNavlink []string
+	}{
+		{
+			name:        "create retried",
+			operation:   v1.Create,
+			verb:        "create",
+			failures:    3,
+			message:     "Navlinks create",
+			wantNavlink: []string{"monitoring-team-project-monitoring-grafana", "monitoring-team-prometheus-operated"},
+		},
+		{
+			name:        "create given up",
+			operation:   v1.Create,
+			verb:        "create",
+			failures:    100,
+			message:     "Navlinks create",
+			wantNavlink: []string{},
+		},
+		{
+			name:        "delete retried",
+			operation:   v1.Delete,
+			navlinks:    []runtime.Object{testNavlink("team", "prometheus-operated")},
+			verb:        "delete",
+			failures:    2,
+			message:     "Navlinks delete",
+			wantNavlink: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			navlinks := NewSimpleFakeUiV1(tt.navlinks...)
+			failures := tt.failures
+			navlinks.PrependReactor(tt.verb, "navlinks", func(k8stesting.Action) (bool, runtime.Object, error) {
+				if failures == 0 {
+					return false, nil, nil
+				}
+				failures--
+				return true, nil, errInternal
+			})
+			nls := NewNavlinksServerHandler(navlinks.NavLinks(), newFakeSources(), testBuilder(t, defaultConfig()), nil)
+			nls.writes = newWriteQueue(navlinks.NavLinks(), workqueue.NewItemExponentialFailureRateLimiter(time.Millisecond, 10*time.Millisecond))
+			nls.changes.writes = nls.writes
+
+			body := testAdmissionReview(t, tt.operation, false, testPrometheus("team", "prometheus"))
+			req := httptest.NewRequest(http.MethodPost, "/validate", bytes.NewReader(body))
+			rec := httptest.NewRecorder()
+			nls.serve(rec, req)
+
+			resp := v1.AdmissionReview{}
+			if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+				t.Fatal(err)
+			}
+			if !resp.Response.Allowed || resp.Response.Result.Message != tt.message {
+				t.Errorf("allowed = %t, message = %q, want %q", resp.Response.Allowed, resp.Response.Result.Message, tt.message)
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			go nls.changes.Run(ctx, 2)
+			go nls.writes.Run(ctx, 2)
+			deadline := time.Now().Add(5 * time.Second)
+			for {
+				nls.changes.mu.Lock()
+				pending := len(nls.changes.pending)
+				nls.changes.mu.Unlock()
+				nls.writes.mu.Lock()
+				pending += len(nls.writes.pending)
+				nls.writes.mu.Unlock()
+				if pending == 0 {
+					break
+				}
+				if time.Now().After(deadline) {
+					t.Fatalf("%d navlink writes still pending", pending)
+				}
+				time.Sleep(time.Millisecond)
+			}
+
+			if names := navlinkNames(t, navlinks); !reflect.DeepEqual(names, tt.wantNavlink) {
+				t.Errorf("navlinks = %v, want %v", names, tt.wantNavlink)
+			}
+		})
+	}
+}
+
+func TestWriteQueueLatestWins(t *testing.T) {
+	navlinks := NewSimpleFakeUiV1(testNavlink("team", "prometheus-operated"))
+	q := newWriteQueue(navlinks.NavLinks(), workqueue.NewItemExponentialFailureRateLimiter(0, 0))
+
+	q.delete("monitoring-team-prometheus-operated")
+	q.create(testNavlink("team", "project-monitoring-grafana"))
+	q.create(testNavlink("team", "prometheus-operated"))
+	q.delete("monitoring-team-project-monitoring-grafana")
+	if got := q.queue.Len(); got != 2 {
+		t.Errorf("queue length = %d, want 2", got)
+	}
+	for q.queue.Len() > 0 {
+		q.processNextItem(context.Background())
+	}
+
+	if names := navlinkNames(t, navlinks); !reflect.DeepEqual(names, []string{"monitoring-team-prometheus-operated"}) {
+		t.Errorf("navlinks = %v, want the navlink kept", names)
+	}
+	if len(q.pending) != 0 {
+		t.Errorf("pending = %v, want none", q.pending)
+	}
+}
+
+func TestWriteQueuePatch(t *testing.T) {
+	grafana := specNavlinks("team", LinkConfig{Name: "grafana", Service: "project-monitoring-grafana", Port: "80"})
+	navlinks := NewSimpleFakeUiV1(&grafana)
+	q := newWriteQueue(navlinks.NavLinks(), workqueue.NewItemExponentialFailureRateLimiter(0, 0))
+
+	// the existing navlink is patched, the missing one is created
+	changed := specNavlinks("team", LinkConfig{Name: "grafana", Service: "project-monitoring-grafana", Port: "3000"})
+	q.patch(&changed)
+	q.patch(testNavlink("team", "prometheus-operated"))
+	for q.queue.Len() > 0 {
+		q.processNextItem(context.Background())
+	}
+
+	verbs := map[string]int{}
+	for _, action := range navlinks.Actions() {
+		verbs[action.GetVerb()]++
+	}
+	if want := map[string]int{"patch": 2, "create": 1}; !reflect.DeepEqual(verbs, want) {
+		t.Errorf("actions = %v, want %v", verbs, want)
+	}
+	got, err := navlinks.NavLinks().Get(context.Background(), grafana.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got.Spec, changed.Spec) {
+		t.Errorf("spec = %+v, want %+v", got.Spec, changed.Spec)
+	}
+	if names := navlinkNames(t, navlinks); !reflect.DeepEqual(names, []string{"monitoring-team-project-monitoring-grafana", "monitoring-team-prometheus-operated"}) {
+		t.Errorf("navlinks = %v", names)
+	}
+}
+
+func TestWriteQueueResourceUnavailable(t *testing.T) {
+	navlinks := NewSimpleFakeUiV1(testNavlink("team", "prometheus-operated"))
+	unavailable := k8serrors.NewNotFound(schema.GroupResource{Group: "ui.cattle.io", Resource: "navlinks"}, "")
+	navlinks.PrependReactor("*", "navlinks", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, unavailable
+	})
+	q := newWriteQueue(navlinks.NavLinks(), workqueue.NewItemExponentialFailureRateLimiter(0, 0))
+
+	q.create(testNavlink("team", "project-monitoring-grafana"))
+	q.patch(testNavlink("team", "prometheus-operated"))
+	for i := 0; i < 2; i++ {
+		q.processNextItem(context.Background())
+	}
+
+	// not retried
+	if got := q.queue.Len(); got != 0 {
+		t.Errorf("queue length = %d, want 0", got)
+	}
+	if len(q.pending) != 0 {
+		t.Errorf("pending = %v, want none", q.pending)
+	}
+	if got := len(navlinks.Actions()); got != 3 {
+		t.Errorf("actions = %d, want create, patch and create", got)
+	}
+}
+
+func TestResourceUnavailable(t *testing.T) {
+	resource := schema.GroupResource{Group: "ui.cattle.io", Resource: "navlinks"}
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "no match", err: &meta.NoKindMatchError{GroupKind: schema.GroupKind{Group: "ui.cattle.io", Kind: "NavLink"}}, want: true},
+		{name: "resource not found", err: fmt.Errorf("creating navlink: %w", k8serrors.NewNotFound(resource, "")), want: true},
+		{name: "navlink not found", err: fmt.Errorf("getting navlink: %w", k8serrors.NewNotFound(resource, "monitoring-team-prometheus-operated"))},
+		{name: "internal", err: k8serrors.NewInternalError(errors.New("apiserver unavailable"))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := resourceUnavailable(tt.err); got != tt.want {
+				t.Errorf("resourceUnavailable = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriteQueueDrain(t *testing.T) {
+	tests := []struct {
+		name  string
+		block bool
+		want  bool
+	}{
+		{name: "drained", want: true},
+		{name: "timeout", block: true, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			navlinks := NewSimpleFakeUiV1()
+			unblock := make(chan struct{})
+			defer close(unblock)
+			navlinks.PrependReactor("create", "navlinks", func(k8stesting.Action) (bool, runtime.Object, error) {
+				if tt.block {
+					<-unblock
+				}
+				time.Sleep(10 * time.Millisecond)
+				return false, nil, nil
+			})
+			q := newWriteQueue(navlinks.NavLinks(), workqueue.NewItemExponentialFailureRateLimiter(0, 0))
+			q.create(testNavlink("team", "prometheus-operated"))
+			q.create(testNavlink("team", "project-monitoring-grafana"))
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			go q.Run(ctx, 1)
+			if got := q.drain(100 * time.Millisecond); got != tt.want {
+				t.Errorf("drain = %t, want %t", got, tt.want)
+			}
+			if tt.block {
+				// the fake client is locked by the blocked write
+				return
+			}
+			if names := navlinkNames(t, navlinks); len(names) != 2 {
+				t.Errorf("navlinks = %v, want both written", names)
+			}
+		})
+	}
+}
+
+// drainWrites resolves the changes queued by the handler and applies their navlink writes, retries are left
+// in their backoff
+func drainWrites(t *testing.T, nls *NavlinksServerHandler) {
+	t.Helper()
+	for nls.changes.queue.Len() > 0 {
+		nls.changes.processNextItem(context.Background())
+	}
+	for nls.writes.queue.Len() > 0 {
+		nls.writes.processNextItem(context.Background())
+	}
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	uiv1 "github.com/rancher/rancher/pkg/apis/ui.cattle.io/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// NavlinksSchemeGroupVersion is the group version of the navlinks.cattle.io resources
+var NavlinksSchemeGroupVersion = schema.GroupVersion{Group: "navlinks.cattle.io", Version: "v1alpha1"}
+
+const navLinkTemplateKind = "NavLinkTemplate"
+
+// NavLinkTemplate is a cluster-scoped template of a navlink created for each matching Prometheus
+type NavLinkTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec NavLinkTemplateSpec `json:"spec"`
+}
+
+// NavLinkTemplateSpec is the spec of the navlinks, the namespace of toService is the
+// namespace of the Prometheus
+type NavLinkTemplateSpec struct {
+	uiv1.NavLinkSpec `json:",inline"`
+
+	// Selector of the Prometheus labels, all Prometheus when not set
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+}
+
+// NavLinkTemplateList is a list of NavLinkTemplates
+type NavLinkTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []NavLinkTemplate `json:"items"`
+}
+
+// addNavlinksKnownTypes adds the navlinks.cattle.io types to the scheme
+func addNavlinksKnownTypes(scheme *runtime.Scheme) error {
+	scheme.AddKnownTypes(NavlinksSchemeGroupVersion,
+		&NavLinkTemplate{},
+		&NavLinkTemplateList{},
+	)
+	metav1.AddToGroupVersion(scheme, NavlinksSchemeGroupVersion)
+	return nil
+}
+
+// DeepCopyInto copies the receiver into out
+func (in *NavLinkTemplateSpec) DeepCopyInto(out *NavLinkTemplateSpec) {
+	*out = *in
+	in.NavLinkSpec.DeepCopyInto(&out.NavLinkSpec)
+	if in.Selector != nil {
+		out.Selector = in.Selector.DeepCopy()
+	}
+}
+
+// DeepCopyInto copies the receiver into out
+func (in *NavLinkTemplate) DeepCopyInto(out *NavLinkTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy copies the receiver into a new NavLinkTemplate
+func (in *NavLinkTemplate) DeepCopy() *NavLinkTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(NavLinkTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject copies the receiver into a new runtime.Object
+func (in *NavLinkTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto copies the receiver into out
+func (in *NavLinkTemplateList) DeepCopyInto(out *NavLinkTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		out.Items = make([]NavLinkTemplate, len(in.Items))
+		for i := range in.Items {
+			in.Items[i].DeepCopyInto(&out.Items[i])
+		}
+	}
+}
+
+// DeepCopy copies the receiver into a new NavLinkTemplateList
+func (in *NavLinkTemplateList) DeepCopy() *NavLinkTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(NavLinkTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject copies the receiver into a new runtime.Object
+func (in *NavLinkTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	watch "k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes/scheme"
+	rest "k8s.io/client-go/rest"
+)
+
+// NavLinkTemplatesGetter has a method to return a NavLinkTemplateInterface.
+// A group's client should implement this interface.
+type NavLinkTemplatesGetter interface {
+	NavLinkTemplates() NavLinkTemplateInterface
+}
+
+// NavLinkTemplateInterface has methods to work with NavLinkTemplate resources.
+type NavLinkTemplateInterface interface {
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (*NavLinkTemplate, error)
+	List(ctx context.Context, opts metav1.ListOptions) (*NavLinkTemplateList, error)
+	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+	NavLinkTemplateExpansion
+}
+
+// navlinktemplates implements NavLinkTemplateInterface
+type navlinktemplates struct {
+	client rest.Interface
+}
+
+// newNavLinkTemplates returns a NavLinkTemplates
+func newNavLinkTemplates(c *NavlinksV1alpha1Client) *navlinktemplates {
+	return &navlinktemplates{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the navlinktemplate, and returns the corresponding navlinktemplate object, and an error if there is any.
+func (c *navlinktemplates) Get(ctx context.Context, name string, options metav1.GetOptions) (result *NavLinkTemplate, err error) {
+	result = &NavLinkTemplate{}
+	err = c.client.Get().
+		Resource("navlinktemplates").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of NavLinkTemplates that match those selectors.
+func (c *navlinktemplates) List(ctx context.Context, opts metav1.ListOptions) (result *NavLinkTemplateList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &NavLinkTemplateList{}
+	err = c.client.Get().
+		Resource("navlinktemplates").
+		VersionedParams(&opts, ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested navlinktemplates.
+func (c *navlinktemplates) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Resource("navlinktemplates").
+		VersionedParams(&opts, ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	uiv1 "github.com/rancher/rancher/pkg/apis/ui.cattle.io/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
@@ -14,6 +15,10 @@ var Codecs = serializer.NewCodecFactory(Scheme)
 var ParameterCodec = runtime.NewParameterCodec(Scheme)
 var localSchemeBuilder = runtime.SchemeBuilder{
 	uiv1.AddToScheme,
+	monitoringv1.AddToScheme,
+	addNavlinksKnownTypes,
+	addGrafanaKnownTypes,
+	addGatewayKnownTypes,
 }
 var AddToScheme = localSchemeBuilder.AddToScheme
 
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	v1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes/scheme"
+	rest "k8s.io/client-go/rest"
+)
+
+// PrometheusesGetter has a method to return a PrometheusInterface.
+// A group's client should implement this interface.
+type PrometheusesGetter interface {
+	Prometheuses(namespace string) PrometheusInterface
+}
+
+// PrometheusInterface has methods to work with Prometheus resources.
+type PrometheusInterface interface {
+	Update(ctx context.Context, prometheus *v1.Prometheus, opts metav1.UpdateOptions) (*v1.Prometheus, error)
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (*v1.Prometheus, error)
+	List(ctx context.Context, opts metav1.ListOptions) (*v1.PrometheusList, error)
+	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *v1.Prometheus, err error)
+	PrometheusExpansion
+}
+
+// prometheuses implements PrometheusInterface
+type prometheuses struct {
+	client rest.Interface
+	ns     string
+}
+
+// newPrometheuses returns a Prometheuses
+func newPrometheuses(c *MonitoringV1Client, namespace string) *prometheuses {
+	return &prometheuses{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the prometheus, and returns the corresponding prometheus object, and an error if there is any.
+func (c *prometheuses) Get(ctx context.Context, name string, options metav1.GetOptions) (result *v1.Prometheus, err error) {
+	result = &v1.Prometheus{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("prometheuses").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of Prometheuses that match those selectors.
+func (c *prometheuses) List(ctx context.Context, opts metav1.ListOptions) (result *v1.PrometheusList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1.PrometheusList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("prometheuses").
+		VersionedParams(&opts, ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested prometheuses.
+func (c *prometheuses) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("prometheuses").
+		VersionedParams(&opts, ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Update takes the representation of a prometheus and updates it. Returns the server's representation of the prometheus, and an error, if there is any.
+func (c *prometheuses) Update(ctx context.Context, prometheus *v1.Prometheus, opts metav1.UpdateOptions) (result *v1.Prometheus, err error) {
+	result = &v1.Prometheus{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("prometheuses").
+		Name(prometheus.Name).
+		VersionedParams(&opts, ParameterCodec).
+		Body(prometheus).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Patch applies the patch and returns the patched prometheus.
+func (c *prometheuses) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *v1.Prometheus, err error) {
+	result = &v1.Prometheus{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("prometheuses").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	v1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes/scheme"
+	rest "k8s.io/client-go/rest"
+)
+
+// ThanosRulersGetter has a method to return a ThanosRulerInterface.
+// A group's client should implement this interface.
+type ThanosRulersGetter interface {
+	ThanosRulers(namespace string) ThanosRulerInterface
+}
+
+// ThanosRulerInterface has methods to work with ThanosRuler resources.
+type ThanosRulerInterface interface {
+	Update(ctx context.Context, thanosruler *v1.ThanosRuler, opts metav1.UpdateOptions) (*v1.ThanosRuler, error)
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (*v1.ThanosRuler, error)
+	List(ctx context.Context, opts metav1.ListOptions) (*v1.ThanosRulerList, error)
+	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *v1.ThanosRuler, err error)
+	ThanosRulerExpansion
+}
+
+// thanosrulers implements ThanosRulerInterface
+type thanosrulers struct {
+	client rest.Interface
+	ns     string
+}
+
+// newThanosRulers returns a ThanosRulers
+func newThanosRulers(c *MonitoringV1Client, namespace string) *thanosrulers {
+	return &thanosrulers{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the thanosruler, and returns the corresponding thanosruler object, and an error if there is any.
+func (c *thanosrulers) Get(ctx context.Context, name string, options metav1.GetOptions) (result *v1.ThanosRuler, err error) {
+	result = &v1.ThanosRuler{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("thanosrulers").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of ThanosRulers that match those selectors.
+func (c *thanosrulers) List(ctx context.Context, opts metav1.ListOptions) (result *v1.ThanosRulerList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1.ThanosRulerList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("thanosrulers").
+		VersionedParams(&opts, ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested thanosrulers.
+func (c *thanosrulers) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("thanosrulers").
+		VersionedParams(&opts, ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Update takes the representation of a thanosruler and updates it. Returns the server's representation of the thanosruler, and an error, if there is any.
+func (c *thanosrulers) Update(ctx context.Context, thanosruler *v1.ThanosRuler, opts metav1.UpdateOptions) (result *v1.ThanosRuler, err error) {
+	result = &v1.ThanosRuler{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("thanosrulers").
+		Name(thanosruler.Name).
+		VersionedParams(&opts, ParameterCodec).
+		Body(thanosruler).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Patch applies the patch and returns the patched thanosruler.
+func (c *thanosrulers) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *v1.ThanosRuler, err error) {
+	result = &v1.ThanosRuler{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("thanosrulers").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
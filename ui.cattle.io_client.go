@@ -23,6 +23,11 @@ func (c *UiV1Client) Navlinks() NavLinkInterface {
 	return newNavLinks(c)
 }
 
+// NavLinks implements NavLinksGetter, it is the same as Navlinks.
+func (c *UiV1Client) NavLinks() NavLinkInterface {
+	return newNavLinks(c)
+}
+
 // NewForConfig creates a new UiV1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).